@@ -0,0 +1,205 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-slark/slark/config"
+	"github.com/go-slark/slark/pkg/endpoint"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"mosn.io/holmes"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+admin server, 独立端口, 不经过业务中间件, 无鉴权, 默认只监听127.0.0.1:
+GET  /admin/build                 版本及构建信息
+GET  /admin/config                生效配置, 敏感值脱敏
+GET  /admin/log/level             全局及模块日志级别
+PUT  /admin/log/level?level=debug[&module=redis]
+DELETE /admin/log/level?module=redis
+GET  /admin/routes                http / grpc 路由
+POST /admin/profile?type=heap|goroutine|cpu[&seconds=10]   runtime/pprof采集写入DumpPath
+POST /admin/holmes/enable|disable?type=cpu|mem|goroutine|gcheap|thread
+GET  /debug/pprof/
+GET  /metrics
+*/
+
+type Server struct {
+	*http.Server
+	listener net.Listener
+	ready    chan struct{}
+	once     sync.Once
+	err      error
+	network  string
+	address  string
+	mux      *http.ServeMux
+	version  string
+	config   *config.Config
+	mask     []string
+	holmes   *holmes.Holmes
+	dumpPath string
+	https    []Engine
+	grpcs    []*grpc.Server
+}
+
+// Engine transport/http.Server满足该接口
+type Engine interface {
+	Engine() *gin.Engine
+}
+
+type Option func(*Server)
+
+func Network(network string) Option {
+	return func(s *Server) {
+		s.network = network
+	}
+}
+
+// Address 监听地址, 默认127.0.0.1:6060, 对外暴露时需在网关或网络层限制访问
+func Address(addr string) Option {
+	return func(s *Server) {
+		s.address = addr
+	}
+}
+
+// Version 应用版本, 如cmd.Version
+func Version(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+func Config(c *config.Config) Option {
+	return func(s *Server) {
+		s.config = c
+	}
+}
+
+// Mask key包含这些关键字(忽略大小写)时脱敏, ENC()解密的值总是脱敏
+func Mask(keywords ...string) Option {
+	return func(s *Server) {
+		s.mask = keywords
+	}
+}
+
+// Holmes 设置后可运行时开关holmes dump
+func Holmes(h *holmes.Holmes) Option {
+	return func(s *Server) {
+		s.holmes = h
+	}
+}
+
+// DumpPath 手动dump的profile文件目录
+func DumpPath(path string) Option {
+	return func(s *Server) {
+		s.dumpPath = path
+	}
+}
+
+func HTTP(srvs ...Engine) Option {
+	return func(s *Server) {
+		s.https = append(s.https, srvs...)
+	}
+}
+
+func GRPC(srvs ...*grpc.Server) Option {
+	return func(s *Server) {
+		s.grpcs = append(s.grpcs, srvs...)
+	}
+}
+
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		Server:   &http.Server{ReadHeaderTimeout: 5 * time.Second},
+		network:  "tcp",
+		address:  "127.0.0.1:6060",
+		ready:    make(chan struct{}),
+		mux:      http.NewServeMux(),
+		mask:     []string{"password", "secret", "token", "dsn", "key"},
+		dumpPath: os.TempDir(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.mux.HandleFunc("/admin/build", s.build)
+	s.mux.HandleFunc("/admin/config", s.dumpConfig)
+	s.mux.HandleFunc("/admin/log/level", s.level)
+	s.mux.HandleFunc("/admin/routes", s.routes)
+	s.mux.HandleFunc("/admin/profile", s.profile)
+	s.mux.HandleFunc("/admin/holmes/", s.holmesHandler)
+	s.mux.HandleFunc("/debug/pprof/", pprof.Index)
+	s.mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	s.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	s.mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	s.mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	s.mux.Handle("/metrics", promhttp.Handler())
+	s.Handler = s.mux
+	s.err = s.listen()
+	return s
+}
+
+// Handle 注册自定义管理接口
+func (s *Server) Handle(pattern string, h http.Handler) {
+	s.mux.Handle(pattern, h)
+}
+
+func (s *Server) listen() error {
+	l, err := net.Listen(s.network, s.address)
+	if err != nil {
+		return err
+	}
+	s.listener = l
+	return nil
+}
+
+func (s *Server) Endpoint() (*url.URL, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	host, err := endpoint.ParseAddr(s.listener, s.address)
+	if err != nil {
+		return nil, err
+	}
+	return &url.URL{Scheme: "http", Host: host}, nil
+}
+
+func (s *Server) Start() error {
+	if s.err != nil {
+		return s.err
+	}
+	s.once.Do(func() {
+		close(s.ready)
+	})
+	err := s.Serve(s.listener)
+	if !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) Ready() <-chan struct{} {
+	return s.ready
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	return s.Shutdown(ctx)
+}
+
+func (s *Server) masked(key string) bool {
+	key = strings.ToLower(key)
+	for _, kw := range s.mask {
+		if strings.Contains(key, strings.ToLower(kw)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/go-slark/slark/config"
+	"github.com/go-slark/slark/config/secret"
+	"github.com/go-slark/slark/logger"
+	xhttp "github.com/go-slark/slark/transport/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+type source struct {
+	data string
+}
+
+func (s *source) Load() ([]byte, error) {
+	return []byte(s.data), nil
+}
+
+func (s *source) Watch() <-chan struct{} {
+	return make(chan struct{})
+}
+
+func (s *source) Close() error {
+	return nil
+}
+
+func (s *source) Format() string {
+	return "json"
+}
+
+var _ Engine = (*xhttp.Server)(nil)
+
+type engine struct {
+	e *gin.Engine
+}
+
+func (e engine) Engine() *gin.Engine {
+	return e.e
+}
+
+func do(t *testing.T, s *Server, method, target string, v any) int {
+	t.Helper()
+	w := httptest.NewRecorder()
+	s.Handler.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+	if v != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+			t.Fatalf("%s %s: %v", target, w.Body.String(), err)
+		}
+	}
+	return w.Code
+}
+
+func TestAdmin(t *testing.T) {
+	key, _ := secret.GenerateKey()
+	k, _ := secret.ParseKeyring(key)
+	token, _ := k.Encrypt("t1")
+	c := config.New(config.WithSource(&source{data: `{"mysql":{"password":"p","host":"db"},"name":"app",` +
+		`"dbs":[{"name":"a","password":"p2","auth":"` + secret.Wrap(token) + `"}]}`}), config.Decrypt(k))
+	if err := c.Load(); err != nil {
+		t.Fatal(err)
+	}
+	e := engine{gin.New()}
+	e.e.GET("/v1/users", func(*gin.Context) {})
+	g := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(g, health.NewServer())
+	s := NewServer(Address("127.0.0.1:0"), Version("1.4.2"), Config(c), HTTP(e), GRPC(g), DumpPath(t.TempDir()))
+	defer s.listener.Close()
+
+	var build map[string]any
+	if do(t, s, http.MethodGet, "/admin/build", &build) != http.StatusOK || build["version"] != "1.4.2" {
+		t.Fatalf("build %v", build)
+	}
+
+	var entries []config.Entry
+	do(t, s, http.MethodGet, "/admin/config", &entries)
+	values := make(map[string]any)
+	for _, e := range entries {
+		values[e.Key] = e.Value
+	}
+	if values["mysql.password"] != mask || values["mysql.host"] != "db" {
+		t.Fatalf("config %v", values)
+	}
+	// 列表中的map按关键字及解密路径逐项脱敏
+	if dbs := fmt.Sprint(values["dbs"]); dbs != "[map[auth:****** name:a password:******]]" {
+		t.Fatalf("dbs %s", dbs)
+	}
+
+	defer logger.SetLevel(logger.GetLevel())
+	defer logger.ResetModuleLevel("redis")
+	var level struct {
+		Level   string            `json:"level"`
+		Modules map[string]string `json:"modules"`
+	}
+	do(t, s, http.MethodPut, "/admin/log/level?level=warn", &level)
+	do(t, s, http.MethodPut, "/admin/log/level?level=trace&module=redis", &level)
+	if level.Level != "warn" || level.Modules["redis"] != "trace" || !logger.Enabled("redis", logger.TraceLevel) {
+		t.Fatalf("level %v", level)
+	}
+	if do(t, s, http.MethodPut, "/admin/log/level?level=xxx", nil) != http.StatusBadRequest {
+		t.Fatal("invalid level accepted")
+	}
+
+	var routes struct {
+		HTTP []route  `json:"http"`
+		GRPC []string `json:"grpc"`
+	}
+	do(t, s, http.MethodGet, "/admin/routes", &routes)
+	if len(routes.HTTP) != 1 || routes.HTTP[0].Path != "/v1/users" || !strings.Contains(strings.Join(routes.GRPC, ","), "/grpc.health.v1.Health/Check") {
+		t.Fatalf("routes %v", routes)
+	}
+
+	var dump map[string]string
+	do(t, s, http.MethodPost, "/admin/profile?type=goroutine", &dump)
+	if _, err := os.Stat(dump["file"]); err != nil {
+		t.Fatalf("dump %v %v", dump, err)
+	}
+	if do(t, s, http.MethodGet, "/debug/pprof/", nil) != http.StatusOK {
+		t.Fatal("pprof not registered")
+	}
+}
@@ -0,0 +1,210 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-slark/slark/logger"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const mask = "******"
+
+func reply(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func fail(w http.ResponseWriter, code int, err error) {
+	reply(w, code, map[string]string{"error": err.Error()})
+}
+
+func (s *Server) build(w http.ResponseWriter, _ *http.Request) {
+	info := map[string]any{
+		"version": s.version,
+		"go":      runtime.Version(),
+		"os":      runtime.GOOS,
+		"arch":    runtime.GOARCH,
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		info["path"] = bi.Path
+		info["module"] = bi.Main.Version
+		settings := make(map[string]string, len(bi.Settings))
+		for _, kv := range bi.Settings {
+			if strings.HasPrefix(kv.Key, "vcs.") {
+				settings[kv.Key] = kv.Value
+			}
+		}
+		info["vcs"] = settings
+	}
+	reply(w, http.StatusOK, info)
+}
+
+func (s *Server) dumpConfig(w http.ResponseWriter, _ *http.Request) {
+	if s.config == nil {
+		fail(w, http.StatusNotFound, fmt.Errorf("config not registered"))
+		return
+	}
+	// 列表/map中的值按子路径(如dbs.password)脱敏
+	reply(w, http.StatusOK, s.config.MaskedEntries(s.masked))
+}
+
+func (s *Server) level(w http.ResponseWriter, r *http.Request) {
+	module := r.URL.Query().Get("module")
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut, http.MethodPost:
+		lv, err := logger.ParseLevel(r.URL.Query().Get("level"))
+		if err != nil {
+			fail(w, http.StatusBadRequest, err)
+			return
+		}
+		if len(module) > 0 {
+			logger.SetModuleLevel(module, lv)
+		} else {
+			logger.SetLevel(lv)
+		}
+	case http.MethodDelete:
+		if len(module) == 0 {
+			fail(w, http.StatusBadRequest, fmt.Errorf("module required"))
+			return
+		}
+		logger.ResetModuleLevel(module)
+	default:
+		fail(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	modules := make(map[string]string)
+	for mod, lv := range logger.ModuleLevels() {
+		modules[mod] = logger.LevelString(lv)
+	}
+	reply(w, http.StatusOK, map[string]any{
+		"level":   logger.LevelString(logger.GetLevel()),
+		"modules": modules,
+	})
+}
+
+type route struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+}
+
+func (s *Server) routes(w http.ResponseWriter, _ *http.Request) {
+	var https []route
+	for _, srv := range s.https {
+		for _, ri := range srv.Engine().Routes() {
+			https = append(https, route{Method: ri.Method, Path: ri.Path})
+		}
+	}
+	var grpcs []string
+	for _, srv := range s.grpcs {
+		for name, info := range srv.GetServiceInfo() {
+			for _, m := range info.Methods {
+				grpcs = append(grpcs, "/"+name+"/"+m.Name)
+			}
+		}
+	}
+	sort.Slice(https, func(i, j int) bool {
+		if https[i].Path == https[j].Path {
+			return https[i].Method < https[j].Method
+		}
+		return https[i].Path < https[j].Path
+	})
+	sort.Strings(grpcs)
+	reply(w, http.StatusOK, map[string]any{"http": https, "grpc": grpcs})
+}
+
+func (s *Server) profile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		fail(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	path, err := s.dump(r, r.URL.Query().Get("type"))
+	if err != nil {
+		fail(w, http.StatusBadRequest, err)
+		return
+	}
+	reply(w, http.StatusOK, map[string]string{"file": path})
+}
+
+// holmesHandler 运行时开关holmes自动dump
+func (s *Server) holmesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		fail(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	typ := r.URL.Query().Get("type")
+	action := strings.TrimPrefix(r.URL.Path, "/admin/holmes/")
+	if s.holmes == nil {
+		fail(w, http.StatusNotFound, fmt.Errorf("holmes not registered"))
+		return
+	}
+	enable := action == "enable"
+	if !enable && action != "disable" {
+		fail(w, http.StatusNotFound, fmt.Errorf("unknown action %s", action))
+		return
+	}
+	switch typ {
+	case "cpu":
+		toggle(enable, s.holmes.EnableCPUDump, s.holmes.DisableCPUDump)
+	case "mem":
+		toggle(enable, s.holmes.EnableMemDump, s.holmes.DisableMemDump)
+	case "goroutine":
+		toggle(enable, s.holmes.EnableGoroutineDump, s.holmes.DisableGoroutineDump)
+	case "gcheap":
+		toggle(enable, s.holmes.EnableGCHeapDump, s.holmes.DisableGCHeapDump)
+	case "thread":
+		toggle(enable, s.holmes.EnableThreadDump, s.holmes.DisableThreadDump)
+	default:
+		fail(w, http.StatusBadRequest, fmt.Errorf("unknown dump type %s", typ))
+		return
+	}
+	reply(w, http.StatusOK, map[string]any{"type": typ, "enable": enable})
+}
+
+func toggle[T any](enable bool, on, off func() T) {
+	if enable {
+		on()
+		return
+	}
+	off()
+}
+
+// dump 手动采集profile写入文件, 与holmes自动dump的文件放在同一目录便于收集
+func (s *Server) dump(r *http.Request, typ string) (string, error) {
+	name := filepath.Join(s.dumpPath, fmt.Sprintf("%s.%s.%d.pprof", typ, time.Now().Format("20060102150405"), os.Getpid()))
+	if typ != "cpu" && pprof.Lookup(typ) == nil {
+		return "", fmt.Errorf("unknown profile %s", typ)
+	}
+	f, err := os.Create(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if typ != "cpu" {
+		return name, pprof.Lookup(typ).WriteTo(f, 0)
+	}
+	seconds, _ := strconv.Atoi(r.URL.Query().Get("seconds"))
+	if seconds <= 0 {
+		seconds = 10
+	}
+	err = pprof.StartCPUProfile(f)
+	if err != nil {
+		return "", err
+	}
+	select {
+	case <-r.Context().Done():
+	case <-time.After(time.Duration(seconds) * time.Second):
+	}
+	pprof.StopCPUProfile()
+	return name, nil
+}
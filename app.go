@@ -2,6 +2,8 @@ package slark
 
 import (
 	"context"
+	"errors"
+	"github.com/go-slark/slark/health"
 	"github.com/go-slark/slark/registry"
 	"github.com/go-slark/slark/transport"
 	"github.com/google/uuid"
@@ -14,17 +16,39 @@ import (
 	"time"
 )
 
+type Hook func(ctx context.Context) error
+
 type App struct {
-	servers  []transport.Server
-	signals  []os.Signal
-	registry registry.Registry
-	name     string
-	version  string
-	metadata map[string]string
+	ctx         context.Context
+	cancel      context.CancelFunc
+	servers     []transport.Server
+	signals     []os.Signal
+	registry    registry.Registry
+	health      *health.Health
+	name        string
+	version     string
+	metadata    map[string]string
+	regTimeout  time.Duration
+	stopTimeout time.Duration
+	drain       time.Duration
+	beforeStart []Hook
+	afterStart  []Hook
+	beforeStop  []Hook
+	afterStop   []Hook
+	l           sync.Mutex
+	svc         *registry.Service
+	done        chan struct{}
+	once        sync.Once
 }
 
 type Option func(*App)
 
+func Context(ctx context.Context) Option {
+	return func(app *App) {
+		app.ctx = ctx
+	}
+}
+
 func Server(srv ...transport.Server) Option {
 	return func(app *App) {
 		app.servers = srv
@@ -43,6 +67,13 @@ func Registry(r registry.Registry) Option {
 	}
 }
 
+// Health 退出时置为NOT_SERVING, 默认使用全局Health
+func Health(h *health.Health) Option {
+	return func(app *App) {
+		app.health = h
+	}
+}
+
 func Name(name string) Option {
 	return func(app *App) {
 		app.name = name
@@ -62,58 +93,200 @@ func Metadata(md map[string]string) Option {
 	}
 }
 
+func RegistryTimeout(tm time.Duration) Option {
+	return func(app *App) {
+		app.regTimeout = tm
+	}
+}
+
+func StopTimeout(tm time.Duration) Option {
+	return func(app *App) {
+		app.stopTimeout = tm
+	}
+}
+
+// DrainTimeout 注销服务后等待客户端感知摘除的时间, 之后再停止server
+func DrainTimeout(tm time.Duration) Option {
+	return func(app *App) {
+		app.drain = tm
+	}
+}
+
+func BeforeStart(h ...Hook) Option {
+	return func(app *App) {
+		app.beforeStart = append(app.beforeStart, h...)
+	}
+}
+
+func AfterStart(h ...Hook) Option {
+	return func(app *App) {
+		app.afterStart = append(app.afterStart, h...)
+	}
+}
+
+func BeforeStop(h ...Hook) Option {
+	return func(app *App) {
+		app.beforeStop = append(app.beforeStop, h...)
+	}
+}
+
+func AfterStop(h ...Hook) Option {
+	return func(app *App) {
+		app.afterStop = append(app.afterStop, h...)
+	}
+}
+
 func NewApp(opts ...Option) *App {
 	app := &App{
-		signals: []os.Signal{syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGINT, syscall.SIGSEGV},
+		ctx:         context.Background(),
+		signals:     []os.Signal{syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGINT, syscall.SIGSEGV},
+		health:      health.GetHealth(),
+		regTimeout:  10 * time.Second,
+		stopTimeout: 5 * time.Second,
+		done:        make(chan struct{}),
 	}
 	for _, opt := range opts {
 		opt(app)
 	}
+	app.ctx, app.cancel = context.WithCancel(app.ctx)
 	return app
 }
 
+// Run lifecycle: BeforeStart -> start servers -> wait ready -> register -> AfterStart
+// -> signal / Stop / server error -> NOT_SERVING -> BeforeStop -> unregister -> drain -> stop servers -> AfterStop
 func (a *App) Run() error {
-	c := make(chan os.Signal, 1)
-	eg, ctx := errgroup.WithContext(context.TODO())
-	wg := sync.WaitGroup{}
+	for _, h := range a.beforeStart {
+		if err := h(a.ctx); err != nil {
+			return err
+		}
+	}
+
+	eg, ctx := errgroup.WithContext(a.ctx)
+	// stop servers only after unregister and drain
+	stop := make(chan struct{})
 	for _, server := range a.servers {
-		wg.Add(1)
 		s := server
 		eg.Go(func() error {
-			wg.Done()
 			return s.Start()
 		})
 
 		eg.Go(func() error {
-			<-c
-			cx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			select {
+			case <-stop:
+			case <-ctx.Done():
+			}
+			cx, cancel := context.WithTimeout(context.Background(), a.stopTimeout)
 			defer cancel()
 			return s.Stop(cx)
 		})
 	}
-	wg.Wait()
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, a.signals...)
+	defer signal.Stop(c)
+
+	err := a.ready(ctx)
+	if err == nil {
+		err = a.register(ctx)
+	}
+	if err == nil {
+		for _, h := range a.afterStart {
+			if err = h(ctx); err != nil {
+				break
+			}
+		}
+	}
+	if err == nil {
+		select {
+		case <-c:
+		case <-a.done:
+		case <-ctx.Done():
+		}
+	}
+
+	var errs []error
+	errs = append(errs, err)
+	if a.health != nil {
+		a.health.Shutdown()
+	}
+	for _, h := range a.beforeStop {
+		errs = append(errs, h(context.Background()))
+	}
+	errs = append(errs, a.unregister())
+	if a.drain > 0 && ctx.Err() == nil {
+		tm := time.NewTimer(a.drain)
+		select {
+		case <-tm.C:
+		case <-ctx.Done():
+		}
+		tm.Stop()
+	}
+	close(stop)
+	errs = append(errs, eg.Wait())
+	a.cancel()
+	for _, h := range a.afterStop {
+		errs = append(errs, h(context.Background()))
+	}
+	for _, e := range errs {
+		if e != nil && !errors.Is(e, context.Canceled) {
+			return e
+		}
+	}
+	return nil
+}
+
+// Stop 主动触发App退出, 与收到信号的流程一致
+func (a *App) Stop() {
+	a.once.Do(func() {
+		close(a.done)
+	})
+}
+
+func (a *App) ready(ctx context.Context) error {
+	for _, srv := range a.servers {
+		r, ok := srv.(transport.Ready)
+		if !ok {
+			continue
+		}
+		select {
+		case <-r.Ready():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (a *App) register(ctx context.Context) error {
 	svc, err := a.service()
 	if err != nil {
 		return err
 	}
 	if a.registry != nil {
-		cx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		cx, cancel := context.WithTimeout(ctx, a.regTimeout)
 		defer cancel()
 		err = a.registry.Register(cx, svc)
 		if err != nil {
 			return err
 		}
 	}
-	signal.Notify(c, a.signals...)
-	<-c
-	close(c)
+	a.l.Lock()
+	a.svc = svc
+	a.l.Unlock()
+	return nil
+}
 
-	if a.registry != nil {
-		cx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
-		defer cancel()
-		return a.registry.Unregister(cx, svc)
+func (a *App) unregister() error {
+	a.l.Lock()
+	svc := a.svc
+	a.svc = nil
+	a.l.Unlock()
+	if a.registry == nil || svc == nil {
+		return nil
 	}
-	return eg.Wait()
+	cx, cancel := context.WithTimeout(context.Background(), a.regTimeout)
+	defer cancel()
+	return a.registry.Unregister(cx, svc)
 }
 
 func (a *App) service() (*registry.Service, error) {
@@ -0,0 +1,89 @@
+package slark
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type mockServer struct {
+	err   error
+	ready chan struct{}
+	stop  chan struct{}
+}
+
+func newMockServer(err error) *mockServer {
+	return &mockServer{err: err, ready: make(chan struct{}), stop: make(chan struct{})}
+}
+
+func (s *mockServer) Start() error {
+	if s.err != nil {
+		return s.err
+	}
+	close(s.ready)
+	<-s.stop
+	return nil
+}
+
+func (s *mockServer) Stop(_ context.Context) error {
+	select {
+	case <-s.stop:
+	default:
+		close(s.stop)
+	}
+	return nil
+}
+
+func (s *mockServer) Ready() <-chan struct{} {
+	return s.ready
+}
+
+func TestAppStartError(t *testing.T) {
+	e := errors.New("listen error")
+	ok := newMockServer(nil)
+	app := NewApp(Server(ok, newMockServer(e)))
+	err := app.Run()
+	if !errors.Is(err, e) {
+		t.Fatalf("expect %v, got %v", e, err)
+	}
+	select {
+	case <-ok.stop:
+	default:
+		t.Fatal("server not stopped")
+	}
+}
+
+func TestAppLifecycle(t *testing.T) {
+	var seq []string
+	hook := func(name string) Hook {
+		return func(ctx context.Context) error {
+			seq = append(seq, name)
+			return nil
+		}
+	}
+	var app *App
+	app = NewApp(
+		Server(newMockServer(nil)),
+		DrainTimeout(10*time.Millisecond),
+		BeforeStart(hook("before_start")),
+		AfterStart(hook("after_start"), func(ctx context.Context) error {
+			app.Stop()
+			return nil
+		}),
+		BeforeStop(hook("before_stop")),
+		AfterStop(hook("after_stop")),
+	)
+	if err := app.Run(); err != nil {
+		t.Fatal(err)
+	}
+	expect := []string{"before_start", "after_start", "before_stop", "after_stop"}
+	if len(seq) != len(expect) {
+		t.Fatalf("expect %v, got %v", expect, seq)
+	}
+	for i := range expect {
+		if seq[i] != expect[i] {
+			t.Fatalf("expect %v, got %v", expect, seq)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Package plugintest protoc插件的golden测试, 编译testdata中的proto后与.golden文件比较
+package plugintest
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// go test -update 重新生成golden文件
+var update = flag.Bool("update", false, "update golden files")
+
+// Plugin 编译proto并构造插件请求, importPaths中依次查找依赖
+func Plugin(t *testing.T, opts protogen.Options, importPaths []string, name, param string) *protogen.Plugin {
+	t.Helper()
+	c := protocompile.Compiler{
+		Resolver:       protocompile.WithStandardImports(&protocompile.SourceResolver{ImportPaths: importPaths}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	fs, err := c.Compile(context.Background(), name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		files []*descriptorpb.FileDescriptorProto
+		seen  = map[string]bool{}
+		walk  func(f protoreflect.FileDescriptor)
+	)
+	// 依赖在前
+	walk = func(f protoreflect.FileDescriptor) {
+		if seen[f.Path()] {
+			return
+		}
+		seen[f.Path()] = true
+		imports := f.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			walk(imports.Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(f))
+	}
+	walk(fs[0])
+	// 与protoc一致经序列化传递, options中的扩展按插件注册的类型解析
+	b, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{name},
+		Parameter:      &param,
+		ProtoFile:      files,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err = proto.Unmarshal(b, req); err != nil {
+		t.Fatal(err)
+	}
+	gen, err := opts.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gen
+}
+
+// Golden 比较生成的文件与dir中的<文件名>.golden
+func Golden(t *testing.T, gen *protogen.Plugin, dir string) {
+	t.Helper()
+	rsp := gen.Response()
+	if rsp.Error != nil {
+		t.Fatal(rsp.GetError())
+	}
+	if len(rsp.File) == 0 {
+		t.Fatal("no file generated")
+	}
+	for _, f := range rsp.File {
+		path := filepath.Join(dir, filepath.Base(f.GetName())+".golden")
+		if *update {
+			if err := os.WriteFile(path, []byte(f.GetContent()), 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.GetContent() != string(want) {
+			t.Errorf("%s mismatch, run go test -update and review the diff:\n%s", f.GetName(), diff(string(want), f.GetContent()))
+		}
+	}
+}
+
+// diff 第一处不同的行
+func diff(want, got string) string {
+	w, g := strings.Split(want, "\n"), strings.Split(got, "\n")
+	for i := 0; i < len(w) && i < len(g); i++ {
+		if w[i] != g[i] {
+			return fmt.Sprintf("line %d:\n- %s\n+ %s", i+1, w[i], g[i])
+		}
+	}
+	return "length differs"
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// formats 插件参数以逗号分隔, 多种格式时重复指定catalog
+type formats []string
+
+func (f *formats) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *formats) Set(s string) error {
+	switch s {
+	case "markdown", "md", "json":
+		*f = append(*f, s)
+		return nil
+	}
+	return fmt.Errorf("unsupported catalog format '%s'", s)
+}
+
+type catalogEntry struct {
+	Enum     string   `json:"enum"`
+	Reason   string   `json:"reason"`
+	Code     int      `json:"code"`
+	GRPCCode string   `json:"grpc_code"`
+	Message  string   `json:"message,omitempty"`
+	Params   []string `json:"params,omitempty"`
+	Comment  string   `json:"comment,omitempty"`
+}
+
+// generateCatalog 输出错误码文档, 通过--errors_opt=catalog=markdown,catalog=json开启
+func generateCatalog(gen *protogen.Plugin, file *protogen.File, errs []*errorInfo) {
+	if len(catalog) == 0 || len(errs) == 0 {
+		return
+	}
+	entries := make([]*catalogEntry, 0, len(errs))
+	for _, e := range errs {
+		params := make([]string, 0, len(e.Params))
+		for _, p := range e.Params {
+			params = append(params, p.Key)
+		}
+		entries = append(entries, &catalogEntry{
+			Enum:     e.Name,
+			Reason:   e.Value,
+			Code:     e.ErrCode,
+			GRPCCode: grpcCodeName(e.GRPCCode),
+			Message:  e.Message,
+			Params:   params,
+			Comment:  e.Doc,
+		})
+	}
+	for _, format := range catalog {
+		switch format {
+		case "markdown", "md":
+			g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+"_errors.md", "")
+			generateMarkdown(g, file, entries)
+		case "json":
+			g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+"_errors.json", "")
+			b, err := json.MarshalIndent(map[string]interface{}{
+				"file":    file.Desc.Path(),
+				"package": string(file.Desc.Package()),
+				"errors":  entries,
+			}, "", "  ")
+			if err != nil {
+				panic(err)
+			}
+			g.P(string(b))
+		}
+	}
+}
+
+func generateMarkdown(g *protogen.GeneratedFile, file *protogen.File, entries []*catalogEntry) {
+	g.P("<!-- Code generated by protoc-gen-go-errors. DO NOT EDIT. -->")
+	g.P()
+	g.P("# ", file.Desc.Package(), " errors")
+	g.P()
+	g.P("source: `", file.Desc.Path(), "`")
+	enum := ""
+	for _, e := range entries {
+		if e.Enum != enum {
+			enum = e.Enum
+			g.P()
+			g.P("## ", enum)
+			g.P()
+			g.P("| Reason | HTTP | gRPC | Message | Params | Description |")
+			g.P("| --- | --- | --- | --- | --- | --- |")
+		}
+		params := make([]string, 0, len(e.Params))
+		for _, p := range e.Params {
+			params = append(params, "`"+p+"`")
+		}
+		message := ""
+		if len(e.Message) > 0 {
+			message = "`" + e.Message + "`"
+		}
+		g.P("| ", e.Reason, " | ", e.Code, " | ", e.GRPCCode, " | ", cell(message), " | ", strings.Join(params, ", "), " | ", cell(e.Comment), " |")
+	}
+}
+
+var cellReplacer = strings.NewReplacer("|", "\\|", "\r\n", "<br>", "\n", "<br>")
+
+func cell(s string) string {
+	return cellReplacer.Replace(s)
+}
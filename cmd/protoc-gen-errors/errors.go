@@ -3,18 +3,25 @@ package main
 import (
 	"fmt"
 	"github.com/go-slark/slark/cmd/protoc-gen-errors/errors"
+	serrors "github.com/go-slark/slark/errors"
+	"go/token"
 	"strings"
+	"text/template"
+	"text/template/parse"
 	"unicode"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	rpc "google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
 )
 
 const (
 	errorsPackage = protogen.GoImportPath("github.com/go-slark/slark/errors")
+	codesPackage  = protogen.GoImportPath("google.golang.org/grpc/codes")
 	fmtPackage    = protogen.GoImportPath("fmt")
 )
 
@@ -37,77 +44,182 @@ func generateFile(gen *protogen.Plugin, file *protogen.File) *protogen.Generated
 	g.P("package ", file.GoPackageName)
 	g.P()
 	g.QualifiedGoIdent(fmtPackage.Ident(""))
-	generateFileContent(gen, file, g)
+	errs := generateFileContent(gen, file, g)
+	generateCatalog(gen, file, errs)
 	return g
 }
 
-func generateFileContent(gen *protogen.Plugin, file *protogen.File, g *protogen.GeneratedFile) {
+func generateFileContent(gen *protogen.Plugin, file *protogen.File, g *protogen.GeneratedFile) []*errorInfo {
 	if len(file.Enums) == 0 {
-		return
+		return nil
 	}
 
 	g.P("// This is a compile-time assertion to ensure that this generated file")
 	g.P("// is compatible with the slark package it is being compiled against.")
 	g.P("const _ = ", errorsPackage.Ident("SupportPackageIsVersion1"))
 	g.P()
-	index := 0
+	var errs []*errorInfo
 	for _, enum := range file.Enums {
-		if !genErrorsReason(gen, file, g, enum) {
-			index++
-		}
+		errs = append(errs, genErrorsReason(gen, file, g, enum)...)
 	}
 	// If all enums do not contain 'errors.code', the current file is skipped
-	if index == 0 {
+	if len(errs) == 0 {
 		g.Skip()
 	}
+	return errs
 }
 
-func genErrorsReason(gen *protogen.Plugin, file *protogen.File, g *protogen.GeneratedFile, enum *protogen.Enum) bool {
+func genErrorsReason(gen *protogen.Plugin, file *protogen.File, g *protogen.GeneratedFile, enum *protogen.Enum) []*errorInfo {
 	defaultCode := proto.GetExtension(enum.Desc.Options(), errors.E_DefaultCode)
 	code := 0
 	if ok := defaultCode.(int32); ok != 0 {
 		code = int(ok)
 	}
-	if code > maxErrCode || code < minErrCode {
+	if code != 0 && (code > maxErrCode || code < minErrCode) {
 		panic(fmt.Sprintf("Enum '%s' range must be greater than or equal to %d and less than or equal to %d", string(enum.Desc.Name()), minErrCode, maxErrCode))
 	}
 	var ew errorWrapper
 	for _, v := range enum.Values {
 		enumCode := code
-		eCode := proto.GetExtension(v.Desc.Options(), errors.E_Code)
-		if ok := eCode.(int32); ok != 0 {
-			enumCode = int(ok)
+		eCode := proto.GetExtension(v.Desc.Options(), errors.E_Code).(int32)
+		gCode := proto.GetExtension(v.Desc.Options(), errors.E_GrpcCode).(int32)
+		if _, ok := rpc.Code_name[gCode]; !ok || gCode < 0 {
+			panic(fmt.Sprintf("Enum '%s' grpc code %d is invalid", string(v.Desc.Name()), gCode))
 		}
-		// If the current enumeration does not contain 'errors.code'
-		// or the code value exceeds the range, the current enum will be skipped
-		if enumCode > maxErrCode || enumCode < minErrCode {
-			panic(fmt.Sprintf("Enum '%s' range must be greater than or equal to %d and less than or equal to %d", string(v.Desc.Name()), minErrCode, maxErrCode))
+		switch {
+		case eCode != 0:
+			enumCode = int(eCode)
+		case gCode != 0:
+			enumCode = serrors.GRPCToHTTPCode(codes.Code(gCode))
 		}
+		// If the current enumeration does not contain 'errors.code',
+		// the current enum will be skipped
 		if enumCode == 0 {
 			continue
 		}
+		if enumCode > maxErrCode || enumCode < minErrCode {
+			panic(fmt.Sprintf("Enum '%s' range must be greater than or equal to %d and less than or equal to %d", string(v.Desc.Name()), minErrCode, maxErrCode))
+		}
 
 		comment := v.Comments.Leading.String()
 		if comment == "" {
 			comment = v.Comments.Trailing.String()
 		}
 
-		err := &errorInfo{
+		msg := proto.GetExtension(v.Desc.Options(), errors.E_Message).(string)
+		params, err := templateParams(msg)
+		if err != nil {
+			panic(fmt.Sprintf("Enum '%s' message template is invalid: %v", string(v.Desc.Name()), err))
+		}
+
+		// 显式的grpc_code与http code的默认映射不一致时, 生成代码覆盖grpc code
+		grpcCode := serrors.HTTPToGRPCCode(enumCode)
+		override := ""
+		if gCode != 0 && codes.Code(gCode) != grpcCode {
+			grpcCode = codes.Code(gCode)
+			override = g.QualifiedGoIdent(codesPackage.Ident(grpcCode.String()))
+		}
+
+		ew.Errors = append(ew.Errors, &errorInfo{
 			Name:       string(enum.Desc.Name()),
 			Value:      string(v.Desc.Name()),
 			CamelValue: case2Camel(string(v.Desc.Name())),
 			ErrCode:    enumCode,
+			GRPCCode:   grpcCode,
+			Override:   override,
 			Comment:    comment,
 			HasComment: len(comment) > 0,
-		}
-		ew.Errors = append(ew.Errors, err)
+			Message:    msg,
+			HasMessage: len(msg) > 0,
+			Params:     params,
+			Doc:        strings.TrimSpace(string(v.Comments.Leading) + string(v.Comments.Trailing)),
+		})
 	}
 	if len(ew.Errors) == 0 {
-		return true
+		return nil
 	}
 	g.P(ew.execute())
 
-	return false
+	return ew.Errors
+}
+
+// 生成函数中已使用的标识符
+var reserved = map[string]bool{"md": true, "errors": true, "fmt": true, "string": true}
+
+// templateParams 按出现顺序提取模板中的{{.key}}
+func templateParams(msg string) ([]*param, error) {
+	if len(msg) == 0 {
+		return nil, nil
+	}
+	tmpl, err := template.New("message").Parse(msg)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		params []*param
+		keys   = map[string]struct{}{}
+		args   = map[string]struct{}{}
+		walk   func(node parse.Node)
+	)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, c := range n.Nodes {
+				walk(c)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, c := range n.Cmds {
+				walk(c)
+			}
+		case *parse.CommandNode:
+			for _, c := range n.Args {
+				walk(c)
+			}
+		case *parse.IfNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.FieldNode:
+			key := n.Ident[0]
+			if _, ok := keys[key]; ok {
+				return
+			}
+			keys[key] = struct{}{}
+			arg := case2Camel(key)
+			arg = strings.ToLower(arg[:1]) + arg[1:]
+			for _, ok := args[arg]; ok || token.IsKeyword(arg) || reserved[arg]; _, ok = args[arg] {
+				arg += "_"
+			}
+			args[arg] = struct{}{}
+			params = append(params, &param{Key: key, Arg: arg})
+		}
+	}
+	walk(tmpl.Tree.Root)
+	return params, nil
+}
+
+// grpcCodeName google.rpc.Code中的名称, 如ALREADY_EXISTS
+func grpcCodeName(c codes.Code) string {
+	if name, ok := rpc.Code_name[int32(c)]; ok {
+		return name
+	}
+	return c.String()
 }
 
 var enCases = cases.Title(language.AmericanEnglish, cases.NoLower)
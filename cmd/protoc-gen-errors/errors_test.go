@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/go-slark/slark/cmd/internal/plugintest"
+	"google.golang.org/protobuf/compiler/protogen"
+	"strings"
+	"testing"
+)
+
+func generate(gen *protogen.Plugin) {
+	for _, f := range gen.Files {
+		if f.Generate {
+			generateFile(gen, f)
+		}
+	}
+}
+
+func TestGolden(t *testing.T) {
+	defer func() {
+		catalog = nil
+	}()
+	gen := plugintest.Plugin(t, protogen.Options{ParamFunc: flags.Set}, []string{"testdata", "."}, "user_errors.proto", "catalog=markdown,catalog=json")
+	generate(gen)
+	plugintest.Golden(t, gen, "testdata")
+}
+
+func TestInvalidGRPCCode(t *testing.T) {
+	gen := plugintest.Plugin(t, protogen.Options{}, []string{"testdata", "."}, "invalid_errors.proto", "")
+	defer func() {
+		r := recover()
+		if r == nil || !strings.Contains(r.(string), "grpc code 99 is invalid") {
+			t.Fatalf("unexpected panic %v", r)
+		}
+	}()
+	generate(gen)
+}
@@ -9,7 +9,16 @@ import (
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
-var showVersion = flag.Bool("version", false, "print the version and exit")
+var (
+	showVersion = flag.Bool("version", false, "print the version and exit")
+
+	flags   flag.FlagSet
+	catalog formats
+)
+
+func init() {
+	flags.Var(&catalog, "catalog", "error catalog format for api docs: markdown or json, may be repeated")
+}
 
 func main() {
 	flag.Parse()
@@ -17,7 +26,6 @@ func main() {
 		fmt.Printf("protoc-gen-go-errors %v\n", cmd.Version)
 		return
 	}
-	var flags flag.FlagSet
 	protogen.Options{
 		ParamFunc: flags.Set,
 	}.Run(func(gen *protogen.Plugin) error {
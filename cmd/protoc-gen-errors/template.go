@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"google.golang.org/grpc/codes"
 	"text/template"
 )
 
@@ -19,19 +20,41 @@ func Is{{.CamelValue}}(err error) bool {
 
 {{ if .HasComment }}{{ .Comment }}{{ end -}}
 func Error{{ .CamelValue }}(format string, args ...interface{}) *errors.Error {
-	 return errors.New({{ .ErrCode }}, fmt.Sprintf(format, args...), {{ .Name }}_{{ .Value }}.String())
+	 return errors.New({{ .ErrCode }}, fmt.Sprintf(format, args...), {{ .Name }}_{{ .Value }}.String()){{ if .Override }}.WithGRPCCode({{ .Override }}){{ end }}
 }
 
+{{- if .HasMessage }}
+
+{{ if .HasComment }}{{ .Comment }}{{ end -}}
+func Error{{ .CamelValue }}WithArgs({{ range $i, $p := .Params }}{{ if $i }}, {{ end }}{{ $p.Arg }}{{ end }}{{ if .Params }} string{{ end }}) *errors.Error {
+	md := map[string]string{ {{- range $i, $p := .Params }}{{ if $i }}, {{ end }}{{ printf "%q" $p.Key }}: {{ $p.Arg }}{{ end -}} }
+	return errors.New({{ .ErrCode }}, errors.Render({{ printf "%q" .Message }}, md), {{ .Name }}_{{ .Value }}.String()){{ if .Override }}.WithGRPCCode({{ .Override }}){{ end }}.WithMetadata(md)
+}
+{{- end }}
+
 {{- end }}
 `
 
 type errorInfo struct {
-	Name       string
-	Value      string
-	ErrCode    int
+	Name     string
+	Value    string
+	ErrCode  int
+	GRPCCode codes.Code
+	// Override 与默认映射不一致时覆盖的grpc code, 如codes.AlreadyExists
+	Override   string
 	CamelValue string
 	Comment    string
 	HasComment bool
+	Doc        string
+	Message    string
+	HasMessage bool
+	Params     []*param
+}
+
+// param 错误信息模板中的命名参数, Key写入Metadata, Arg为生成函数的参数名
+type param struct {
+	Key string
+	Arg string
 }
 
 type errorWrapper struct {
@@ -66,9 +66,6 @@ func genService(gen *protogen.Plugin, file *protogen.File, g *protogen.Generated
 		Metadata:    file.Desc.Path(),
 	}
 	for _, method := range service.Methods {
-		if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
-			continue
-		}
 		rule, ok := proto.GetExtension(method.Desc.Options(), annotations.E_Http).(*annotations.HttpRule)
 		if rule != nil && ok {
 			for _, bind := range rule.AdditionalBindings {
@@ -77,7 +74,14 @@ func genService(gen *protogen.Plugin, file *protogen.File, g *protogen.Generated
 			sd.Methods = append(sd.Methods, buildHTTPRule(g, method, rule))
 		} else if !omitempty {
 			path := fmt.Sprintf("/%s/%s", service.Desc.FullName(), method.Desc.Name())
-			sd.Methods = append(sd.Methods, buildMethodDesc(g, method, "POST", path))
+			verb := "POST"
+			if method.Desc.IsStreamingClient() {
+				// WebSocket握手仅支持GET
+				verb = "GET"
+			}
+			md := buildMethodDesc(g, method, verb, path)
+			md.HasBody = true
+			sd.Methods = append(sd.Methods, md)
 		}
 	}
 	if len(sd.Methods) != 0 {
@@ -88,9 +92,6 @@ func genService(gen *protogen.Plugin, file *protogen.File, g *protogen.Generated
 func hasHTTPRule(services []*protogen.Service) bool {
 	for _, service := range services {
 		for _, method := range service.Methods {
-			if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
-				continue
-			}
 			rule, ok := proto.GetExtension(method.Desc.Options(), annotations.E_Http).(*annotations.HttpRule)
 			if rule != nil && ok {
 				return true
@@ -131,21 +132,30 @@ func buildHTTPRule(g *protogen.GeneratedFile, m *protogen.Method, rule *annotati
 	body = rule.Body
 	responseBody = rule.ResponseBody
 	md := buildMethodDesc(g, m, method, path)
+	if m.Desc.IsStreamingClient() {
+		if method != "GET" {
+			_, _ = fmt.Fprintf(os.Stderr, "\u001B[31mWARN\u001B[m: %s %s streaming client requires GET for websocket.\n", method, path)
+		}
+		return md
+	}
+	if m.Desc.IsStreamingServer() && responseBody != "" {
+		_, _ = fmt.Fprintf(os.Stderr, "\u001B[31mWARN\u001B[m: %s %s response_body is ignored for streaming server.\n", method, path)
+		responseBody = ""
+	}
 	if method == "GET" || method == "DELETE" {
 		if body != "" {
 			_, _ = fmt.Fprintf(os.Stderr, "\u001B[31mWARN\u001B[m: %s %s body should not be declared.\n", method, path)
 		}
 	} else {
-		if body != "*" {
+		if body == "" {
 			_, _ = fmt.Fprintf(os.Stderr, "\u001B[31mWARN\u001B[m: %s %s does not declare a body.\n", method, path)
 		}
 	}
 	if body == "*" {
 		md.HasBody = true
-	} else {
-		if !md.HasVars {
-			md.HasQuery = true
-		}
+	} else if body != "" {
+		md.Body = "." + camelCaseVars(body)
+		md.BodyField = body
 	}
 	if responseBody == "*" {
 		md.ResponseBody = ""
@@ -158,11 +168,8 @@ func buildHTTPRule(g *protogen.GeneratedFile, m *protogen.Method, rule *annotati
 func buildMethodDesc(g *protogen.GeneratedFile, m *protogen.Method, method, path string) *methodDesc {
 	defer func() { methodSets[m.GoName]++ }()
 	params := buildPathParams(path)
-	for k, v := range params {
+	for k := range params {
 		fields := m.Input.Desc.Fields()
-		if v != nil {
-			path = replacePath(k, *v, path)
-		}
 		for _, field := range strings.Split(k, ".") {
 			if strings.TrimSpace(field) == "" {
 				continue
@@ -190,18 +197,12 @@ func buildMethodDesc(g *protogen.GeneratedFile, m *protogen.Method, method, path
 		Num:          methodSets[m.GoName],
 		Request:      g.QualifiedGoIdent(m.Input.GoIdent),
 		Reply:        g.QualifiedGoIdent(m.Output.GoIdent),
+		ServerStream: m.Desc.IsStreamingServer(),
+		ClientStream: m.Desc.IsStreamingClient(),
 		Path:         path,
 		Method:       method,
 		HasVars:      len(params) > 0,
 	}
-	paths := strings.Split(md.Path, "/")
-	for index, elem := range paths {
-		l := len(elem)
-		if l > 0 && (elem[0] == '{' && elem[l-1] == '}' || elem[0] == ':') {
-			paths[index] = ":" + elem[1:l-1]
-		}
-	}
-	md.Path = strings.Join(paths, "/")
 	return md
 }
 
@@ -223,20 +224,6 @@ func buildPathParams(path string) (res map[string]*string) {
 	return
 }
 
-func replacePath(name string, value string, path string) string {
-	pattern := regexp.MustCompile(fmt.Sprintf(`(?i){([\s]*%s[\s]*)=?([^{}]*)}`, name))
-	idx := pattern.FindStringIndex(path)
-	if len(idx) > 0 {
-		path = fmt.Sprintf("%s{%s:%s}%s",
-			path[:idx[0]], // The start of the match
-			name,
-			strings.ReplaceAll(value, "*", ".*"),
-			path[idx[1]:],
-		)
-	}
-	return path
-}
-
 func camelCaseVars(s string) string {
 	subs := strings.Split(s, ".")
 	vars := make([]string, 0, len(subs))
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"flag"
+	"github.com/go-slark/slark/cmd/internal/plugintest"
+	"google.golang.org/protobuf/compiler/protogen"
+	"testing"
+)
+
+func TestGolden(t *testing.T) {
+	defer func() {
+		*openapiDoc = false
+	}()
+	gen := plugintest.Plugin(t, protogen.Options{ParamFunc: flag.CommandLine.Set}, []string{"testdata", "../../third_party"}, "user.proto", "openapi=true")
+	for _, f := range gen.Files {
+		if f.Generate {
+			generateFile(gen, f, *omitempty)
+			generateOpenAPI(gen, f, *omitempty)
+		}
+	}
+	plugintest.Golden(t, gen, "testdata")
+}
@@ -12,6 +12,8 @@ import (
 var (
 	showVersion = flag.Bool("version", false, "print the version and exit")
 	omitempty   = flag.Bool("omitempty", true, "omit if google.api is empty")
+	openapiDoc  = flag.Bool("openapi", false, "generate openapi 3.1 document <file>.openapi.json")
+	rawDoc      = flag.Bool("raw", false, "openapi responses without {code,msg,data}, for http.RawEnvelope")
 )
 
 func main() {
@@ -29,6 +31,9 @@ func main() {
 				continue
 			}
 			generateFile(gen, f, *omitempty)
+			if *openapiDoc {
+				generateOpenAPI(gen, f, *omitempty)
+			}
 		}
 		return nil
 	})
@@ -0,0 +1,524 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"regexp"
+	"strings"
+)
+
+// 错误响应, 与transport/http.ErrorEncoder一致, code/reason/message/metadata来自errors.Status
+const errorSchema = "slark.ErrorResponse"
+
+type document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       map[string]string                `json:"info"`
+	Tags       []map[string]string              `json:"tags,omitempty"`
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components map[string]map[string]any        `json:"components"`
+}
+
+type operation struct {
+	Tags        []string       `json:"tags"`
+	Summary     string         `json:"summary,omitempty"`
+	Description string         `json:"description,omitempty"`
+	OperationID string         `json:"operationId"`
+	Deprecated  bool           `json:"deprecated,omitempty"`
+	Parameters  []any          `json:"parameters,omitempty"`
+	RequestBody map[string]any `json:"requestBody,omitempty"`
+	Responses   map[string]any `json:"responses"`
+	// 客户端流/双向流经WebSocket收发的消息
+	WebSocket map[string]any `json:"x-websocket,omitempty"`
+}
+
+type openapi struct {
+	doc     *document
+	schemas map[protoreflect.FullName]*protogen.Message
+	pending []*protogen.Message
+	ops     map[string]int
+}
+
+// generateOpenAPI 根据google.api.http生成OpenAPI 3.1文档<file>.openapi.json
+func generateOpenAPI(gen *protogen.Plugin, file *protogen.File, omitempty bool) *protogen.GeneratedFile {
+	if len(file.Services) == 0 || (omitempty && !hasHTTPRule(file.Services)) {
+		return nil
+	}
+	o := &openapi{
+		doc: &document{
+			OpenAPI: "3.1.0",
+			Info: map[string]string{
+				"title":   string(file.Desc.Package()),
+				"version": "0.0.1",
+			},
+			Paths: map[string]map[string]*operation{},
+		},
+		schemas: map[protoreflect.FullName]*protogen.Message{},
+		ops:     map[string]int{},
+	}
+	for _, service := range file.Services {
+		tag := map[string]string{"name": service.GoName}
+		if d := trimComment(service.Comments.Leading); len(d) > 0 {
+			tag["description"] = d
+		}
+		o.doc.Tags = append(o.doc.Tags, tag)
+		for _, method := range service.Methods {
+			rule, ok := proto.GetExtension(method.Desc.Options(), annotations.E_Http).(*annotations.HttpRule)
+			if rule != nil && ok {
+				o.addRule(service, method, rule)
+				for _, bind := range rule.AdditionalBindings {
+					o.addRule(service, method, bind)
+				}
+			} else if !omitempty {
+				path := fmt.Sprintf("/%s/%s", service.Desc.FullName(), method.Desc.Name())
+				verb := "POST"
+				if method.Desc.IsStreamingClient() {
+					verb = "GET"
+				}
+				o.addOperation(service, method, verb, path, "*", "")
+			}
+		}
+	}
+	if len(o.doc.Paths) == 0 {
+		return nil
+	}
+	o.doc.Components = map[string]map[string]any{"schemas": o.components()}
+
+	b, err := json.MarshalIndent(o.doc, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+".openapi.json", "")
+	g.P(string(b))
+	return g
+}
+
+func (o *openapi) addRule(service *protogen.Service, m *protogen.Method, rule *annotations.HttpRule) {
+	var path, method string
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		path, method = pattern.Get, "GET"
+	case *annotations.HttpRule_Put:
+		path, method = pattern.Put, "PUT"
+	case *annotations.HttpRule_Post:
+		path, method = pattern.Post, "POST"
+	case *annotations.HttpRule_Delete:
+		path, method = pattern.Delete, "DELETE"
+	case *annotations.HttpRule_Patch:
+		path, method = pattern.Patch, "PATCH"
+	case *annotations.HttpRule_Custom:
+		path, method = pattern.Custom.Path, pattern.Custom.Kind
+	}
+	if len(path) == 0 {
+		return
+	}
+	o.addOperation(service, m, method, path, rule.Body, rule.ResponseBody)
+}
+
+var pathVar = regexp.MustCompile(`{\s*([a-zA-Z0-9_.]+)\s*(=[^{}]*)?}`)
+
+func (o *openapi) addOperation(service *protogen.Service, m *protogen.Method, method, path, body, responseBody string) {
+	id := fmt.Sprintf("%s_%s", service.GoName, m.GoName)
+	if n := o.ops[id]; n > 0 {
+		o.ops[id]++
+		id = fmt.Sprintf("%s%d", id, n)
+	} else {
+		o.ops[id] = 1
+	}
+	summary, description := splitComment(trimComment(m.Comments.Leading))
+	op := &operation{
+		Tags:        []string{service.GoName},
+		Summary:     summary,
+		Description: description,
+		OperationID: id,
+		Deprecated:  m.Desc.Options().(*descriptorpb.MethodOptions).GetDeprecated(),
+		Responses: map[string]any{
+			"200": map[string]any{
+				"description": "OK",
+				"content":     content(o.envelope(o.responseSchema(m.Output, responseBody))),
+			},
+			"default": map[string]any{
+				"description": "Error",
+				"content":     content(ref(errorSchema)),
+			},
+		},
+	}
+
+	switch {
+	case m.Desc.IsStreamingClient():
+		// WebSocket握手, 消息经连接收发, 不绑定query及body
+		op.Responses["101"] = map[string]any{"description": "Switching Protocols, WebSocket"}
+		delete(op.Responses, "200")
+		op.WebSocket = map[string]any{
+			"send":    o.messageSchema(m.Input),
+			"receive": o.envelope(o.messageSchema(m.Output)),
+		}
+		body = ""
+	case m.Desc.IsStreamingServer():
+		// 按Accept以SSE(默认)或NDJSON逐条输出
+		schema := o.envelope(o.messageSchema(m.Output))
+		op.Responses["200"] = map[string]any{
+			"description": "OK, 每条消息按Accept以SSE或NDJSON输出",
+			"content": map[string]any{
+				"text/event-stream":    map[string]any{"schema": schema},
+				"application/x-ndjson": map[string]any{"schema": schema},
+			},
+		}
+	}
+
+	// path参数
+	exclude := map[string]struct{}{}
+	for _, match := range pathVar.FindAllStringSubmatch(path, -1) {
+		name := match[1]
+		exclude[strings.Split(name, ".")[0]] = struct{}{}
+		var (
+			schema      = map[string]any{"type": "string"}
+			description string
+		)
+		if f := findField(m.Input, name); f != nil {
+			schema = o.fieldSchema(f)
+			description = trimComment(f.Comments.Leading)
+		}
+		segments := pathSegments(name, match[2])
+		if len(segments) == 0 {
+			op.Parameters = append(op.Parameters, pathParam(name, description, schema))
+			continue
+		}
+		// {name=shelves/*/books/*}与路由一致展开为shelves/{name_1}/books/{name_3}, 各段拼接为name
+		description = strings.TrimSpace(description + " `" + name + "` = " + strings.Join(segments, "/"))
+		for _, segment := range segments {
+			if strings.HasPrefix(segment, "{") {
+				op.Parameters = append(op.Parameters, pathParam(strings.Trim(segment, "{}"), description, map[string]any{"type": "string"}))
+			}
+		}
+	}
+	path = pathVar.ReplaceAllStringFunc(path, func(s string) string {
+		match := pathVar.FindStringSubmatch(s)
+		if segments := pathSegments(match[1], match[2]); len(segments) > 0 {
+			return strings.Join(segments, "/")
+		}
+		return "{" + match[1] + "}"
+	})
+
+	// body
+	switch body {
+	case "*":
+		op.RequestBody = map[string]any{
+			"required": true,
+			"content":  content(o.messageSchema(m.Input)),
+		}
+	case "":
+	default:
+		if f := findField(m.Input, body); f != nil {
+			exclude[strings.Split(body, ".")[0]] = struct{}{}
+			op.RequestBody = map[string]any{
+				"required": true,
+				"content":  content(o.fieldSchema(f)),
+			}
+		}
+	}
+
+	// query参数, body为*时全部字段在body中
+	if body != "*" && !m.Desc.IsStreamingClient() {
+		for _, f := range m.Input.Fields {
+			if _, ok := exclude[string(f.Desc.Name())]; ok {
+				continue
+			}
+			if f.Desc.IsMap() || (f.Desc.Kind() == protoreflect.MessageKind && !isScalarWKT(f.Message)) {
+				continue
+			}
+			param := map[string]any{
+				"name":   string(f.Desc.Name()),
+				"in":     "query",
+				"schema": o.fieldSchema(f),
+			}
+			if d := trimComment(f.Comments.Leading); len(d) > 0 {
+				param["description"] = d
+			}
+			op.Parameters = append(op.Parameters, param)
+		}
+	}
+
+	item, ok := o.doc.Paths[path]
+	if !ok {
+		item = map[string]*operation{}
+		o.doc.Paths[path] = item
+	}
+	item[strings.ToLower(method)] = op
+}
+
+// pathSegments 多段路径变量按transport/http的路由规则展开, 单段变量返回nil
+func pathSegments(name, template string) []string {
+	template = strings.TrimSpace(strings.TrimPrefix(template, "="))
+	if len(template) == 0 || template == "*" {
+		return nil
+	}
+	segments := strings.Split(template, "/")
+	for i, segment := range segments {
+		if segment == "*" || segment == "**" {
+			segments[i] = fmt.Sprintf("{%s_%d}", name, i)
+		}
+	}
+	return segments
+}
+
+func pathParam(name, description string, schema map[string]any) map[string]any {
+	param := map[string]any{
+		"name":     name,
+		"in":       "path",
+		"required": true,
+		"schema":   schema,
+	}
+	if len(description) > 0 {
+		param["description"] = description
+	}
+	return param
+}
+
+// envelope transport/http.DefaultEnvelope写出的{code,msg,data}, raw时不封装
+func (o *openapi) envelope(data any) any {
+	if *rawDoc {
+		return data
+	}
+	return map[string]any{
+		"type":     "object",
+		"required": []string{"code", "msg", "data"},
+		"properties": map[string]any{
+			"code": map[string]any{"type": "integer", "format": "int32"},
+			"msg":  map[string]any{"type": "string"},
+			"data": data,
+		},
+	}
+}
+
+func (o *openapi) responseSchema(m *protogen.Message, responseBody string) any {
+	if len(responseBody) == 0 || responseBody == "*" {
+		return o.messageSchema(m)
+	}
+	if f := findField(m, responseBody); f != nil {
+		return o.fieldSchema(f)
+	}
+	return o.messageSchema(m)
+}
+
+func (o *openapi) messageSchema(m *protogen.Message) map[string]any {
+	if s := wktSchema(m.Desc.FullName()); s != nil {
+		return s
+	}
+	if _, ok := o.schemas[m.Desc.FullName()]; !ok {
+		o.schemas[m.Desc.FullName()] = m
+		o.pending = append(o.pending, m)
+	}
+	return ref(string(m.Desc.FullName()))
+}
+
+func (o *openapi) fieldSchema(f *protogen.Field) map[string]any {
+	if f.Desc.IsMap() {
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": o.kindSchema(f.Message.Fields[1]),
+		}
+	}
+	if f.Desc.IsList() {
+		return map[string]any{
+			"type":  "array",
+			"items": o.kindSchema(f),
+		}
+	}
+	return o.kindSchema(f)
+}
+
+// kindSchema 按protojson(UseProtoNames)的编码规则
+func (o *openapi) kindSchema(f *protogen.Field) map[string]any {
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "contentEncoding": "base64"}
+	case protoreflect.EnumKind:
+		values := make([]string, 0, len(f.Enum.Values))
+		for _, v := range f.Enum.Values {
+			values = append(values, string(v.Desc.Name()))
+		}
+		return map[string]any{"type": "string", "enum": values}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return o.messageSchema(f.Message)
+	}
+	return map[string]any{}
+}
+
+func (o *openapi) components() map[string]any {
+	schemas := map[string]any{
+		errorSchema: map[string]any{
+			"type":     "object",
+			"required": []string{"code", "msg"},
+			"properties": map[string]any{
+				"code":     map[string]any{"type": "integer", "format": "int32", "description": "错误码, errors.Status.code"},
+				"msg":      map[string]any{"type": "string", "description": "用户可读提示, errors.Status.message"},
+				"reason":   map[string]any{"type": "string", "description": "业务错误码, errors.Status.reason"},
+				"metadata": map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}, "description": "错误附加信息, errors.Status.metadata"},
+				"details": map[string]any{
+					"type":        "array",
+					"description": "google.rpc错误详情",
+					"items": map[string]any{
+						"type":                 "object",
+						"required":             []string{"@type"},
+						"properties":           map[string]any{"@type": map[string]any{"type": "string"}},
+						"additionalProperties": true,
+					},
+				},
+				"data": map[string]any{"type": "null"},
+			},
+		},
+	}
+	for len(o.pending) > 0 {
+		m := o.pending[0]
+		o.pending = o.pending[1:]
+		properties := map[string]any{}
+		for _, f := range m.Fields {
+			s := o.fieldSchema(f)
+			if d := trimComment(f.Comments.Leading); len(d) > 0 {
+				// $ref的同级关键字在3.1中有效
+				s = clone(s)
+				s["description"] = d
+			}
+			properties[string(f.Desc.Name())] = s
+		}
+		schema := map[string]any{
+			"type":       "object",
+			"properties": properties,
+		}
+		if d := trimComment(m.Comments.Leading); len(d) > 0 {
+			schema["description"] = d
+		}
+		schemas[string(m.Desc.FullName())] = schema
+	}
+	return schemas
+}
+
+func wktSchema(name protoreflect.FullName) map[string]any {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration":
+		return map[string]any{"type": "string", "pattern": `^-?[0-9]+(\.[0-9]+)?s$`}
+	case "google.protobuf.FieldMask":
+		return map[string]any{"type": "string"}
+	case "google.protobuf.Empty":
+		return map[string]any{"type": "object"}
+	case "google.protobuf.Struct":
+		return map[string]any{"type": "object", "additionalProperties": true}
+	case "google.protobuf.Value":
+		return map[string]any{}
+	case "google.protobuf.ListValue":
+		return map[string]any{"type": "array", "items": map[string]any{}}
+	case "google.protobuf.Any":
+		return map[string]any{
+			"type":                 "object",
+			"required":             []string{"@type"},
+			"properties":           map[string]any{"@type": map[string]any{"type": "string"}},
+			"additionalProperties": true,
+		}
+	case "google.protobuf.BoolValue":
+		return map[string]any{"type": []string{"boolean", "null"}}
+	case "google.protobuf.StringValue":
+		return map[string]any{"type": []string{"string", "null"}}
+	case "google.protobuf.BytesValue":
+		return map[string]any{"type": []string{"string", "null"}, "contentEncoding": "base64"}
+	case "google.protobuf.Int32Value":
+		return map[string]any{"type": []string{"integer", "null"}, "format": "int32"}
+	case "google.protobuf.UInt32Value":
+		return map[string]any{"type": []string{"integer", "null"}, "format": "uint32"}
+	case "google.protobuf.Int64Value":
+		return map[string]any{"type": []string{"string", "null"}, "format": "int64"}
+	case "google.protobuf.UInt64Value":
+		return map[string]any{"type": []string{"string", "null"}, "format": "uint64"}
+	case "google.protobuf.FloatValue":
+		return map[string]any{"type": []string{"number", "null"}, "format": "float"}
+	case "google.protobuf.DoubleValue":
+		return map[string]any{"type": []string{"number", "null"}, "format": "double"}
+	}
+	return nil
+}
+
+// isScalarWKT 可作为query参数的well-known type
+func isScalarWKT(m *protogen.Message) bool {
+	s := wktSchema(m.Desc.FullName())
+	if s == nil {
+		return false
+	}
+	switch s["type"].(type) {
+	case string:
+		return s["type"] == "string"
+	case []string:
+		return true
+	}
+	return false
+}
+
+func findField(m *protogen.Message, path string) *protogen.Field {
+	var field *protogen.Field
+	for _, name := range strings.Split(path, ".") {
+		if m == nil {
+			return nil
+		}
+		field = nil
+		for _, f := range m.Fields {
+			if string(f.Desc.Name()) == strings.TrimSpace(name) {
+				field = f
+				break
+			}
+		}
+		if field == nil {
+			return nil
+		}
+		m = field.Message
+	}
+	return field
+}
+
+func content(schema any) map[string]any {
+	return map[string]any{
+		"application/json": map[string]any{"schema": schema},
+	}
+}
+
+func ref(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+func clone(s map[string]any) map[string]any {
+	c := make(map[string]any, len(s)+1)
+	for k, v := range s {
+		c[k] = v
+	}
+	return c
+}
+
+func trimComment(c protogen.Comments) string {
+	return strings.TrimSpace(string(c))
+}
+
+// splitComment 首行作为summary, 其余作为description
+func splitComment(c string) (string, string) {
+	summary, description, _ := strings.Cut(c, "\n")
+	return strings.TrimSpace(summary), strings.TrimSpace(description)
+}
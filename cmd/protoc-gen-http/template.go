@@ -11,8 +11,14 @@ var httpTemplate = `
 
 type {{.ServiceType}}HTTPServer interface {
 {{- range .MethodSets}}
+{{- if .ClientStream}}
+	{{.Name}}({{$svrType}}_{{.Name}}HTTPServer) error
+{{- else if .ServerStream}}
+	{{.Name}}(*{{.Request}}, {{$svrType}}_{{.Name}}HTTPServer) error
+{{- else}}
 	{{.Name}}(context.Context, *{{.Request}}) (*{{.Reply}}, error)
 {{- end}}
+{{- end}}
 }
 
 func Register{{.ServiceType}}HTTPServer(s *http.Server, srv {{.ServiceType}}HTTPServer) {
@@ -25,41 +31,112 @@ func Register{{.ServiceType}}HTTPServer(s *http.Server, srv {{.ServiceType}}HTTP
 {{range .Methods}}
 func _{{$svrType}}_{{.Name}}{{.Num}}_HTTP_Handler(srv {{$svrType}}HTTPServer) http.HandlerFunc {
 	return func(ctx *http.Context) error {
+{{- if .ClientStream}}
+		return ctx.BidiStream(func(stream http.Stream) error {
+			return srv.{{.Name}}(&_{{$svrType}}_{{.Name}}_HTTPStream{stream})
+		})
+{{- else if .ServerStream}}
+		var in {{.Request}}
+		err := ctx.Bind(&in, "{{if .HasBody}}*{{else}}{{.BodyField}}{{end}}")
+		if err != nil {
+			return err
+		}
+
+		return ctx.ServerStream(&in, func(req interface{}, stream http.Stream) error {
+			return srv.{{.Name}}(req.(*{{.Request}}), &_{{$svrType}}_{{.Name}}_HTTPStream{stream})
+		})
+{{- else}}
 		var (
 			in {{.Request}}
 			out interface{}
 			err error
 		)
 		
-		{{- if .HasBody}}
-		err = ctx.ShouldBind(&in)
-		if err != nil {
-			return err
-		}
-
-		{{- else if .HasQuery}}
-		err = ctx.ShouldBindQuery(&in)
+		err = ctx.Bind(&in, "{{if .HasBody}}*{{else}}{{.BodyField}}{{end}}")
 		if err != nil {
 			return err
 		}
 
-		{{- else}}
-		err = ctx.ShouldBindURI(&in)
-		if err != nil {
-			return err
-		}
-		{{- end}}
-
 		out, err = ctx.Handle(func(ctx context.Context, req interface{}) (interface{}, error) {
 			return srv.{{.Name}}(ctx, req.(*{{.Request}}))
 		})(ctx.Context(), &in)
 		if err != nil {
 			return err
 		}
-		return ctx.Result(out.(*{{.Reply}}))
+		return ctx.Result(out.(*{{.Reply}}){{.ResponseBody}})
+{{- end}}
 	}
 }
 {{end}}
+
+{{- range .MethodSets}}
+{{- if or .ServerStream .ClientStream}}
+type {{$svrType}}_{{.Name}}HTTPServer interface {
+{{- if .ServerStream}}
+	Send(*{{.Reply}}) error
+{{- else}}
+	SendAndClose(*{{.Reply}}) error
+{{- end}}
+{{- if .ClientStream}}
+	Recv() (*{{.Request}}, error)
+{{- end}}
+	Context() context.Context
+}
+
+type _{{$svrType}}_{{.Name}}_HTTPStream struct {
+	http.Stream
+}
+
+{{if .ServerStream -}}
+func (x *_{{$svrType}}_{{.Name}}_HTTPStream) Send(m *{{.Reply}}) error {
+	return x.SendMsg(m)
+}
+{{- else -}}
+func (x *_{{$svrType}}_{{.Name}}_HTTPStream) SendAndClose(m *{{.Reply}}) error {
+	return x.SendMsg(m)
+}
+{{- end}}
+{{if .ClientStream}}
+func (x *_{{$svrType}}_{{.Name}}_HTTPStream) Recv() (*{{.Request}}, error) {
+	m := new({{.Request}})
+	if err := x.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+{{end}}
+{{end}}
+{{- end}}
+
+type {{.ServiceType}}HTTPClient interface {
+{{- range .MethodSets}}
+{{- if not (or .ServerStream .ClientStream)}}
+	{{.Name}}(ctx context.Context, req *{{.Request}}, opts ...http.CallOption) (*{{.Reply}}, error)
+{{- end}}
+{{- end}}
+}
+
+type {{.ServiceType}}HTTPClientImpl struct {
+	cc *http.Client
+}
+
+func New{{.ServiceType}}HTTPClient(client *http.Client) {{.ServiceType}}HTTPClient {
+	return &{{.ServiceType}}HTTPClientImpl{client}
+}
+
+{{range .MethodSets}}
+{{- if not (or .ServerStream .ClientStream)}}
+func (c *{{$svrType}}HTTPClientImpl) {{.Name}}(ctx context.Context, in *{{.Request}}, opts ...http.CallOption) (*{{.Reply}}, error) {
+	var out {{.Reply}}
+	path := http.EncodeURL("{{.Path}}", in, {{not .HasBody}}{{if .Body}}, "{{.BodyField}}"{{end}})
+	err := c.cc.Invoke(ctx, "{{.Method}}", path, {{if .HasBody}}in{{else if .Body}}in{{.Body}}{{else}}nil{{end}}, &out{{.ResponseBody}}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+{{end}}
+{{- end}}
 `
 
 type serviceDesc struct {
@@ -78,12 +155,15 @@ type methodDesc struct {
 	Num          int
 	Request      string
 	Reply        string
+	ServerStream bool
+	ClientStream bool // 客户端流与双向流经WebSocket
 	// http_rule
-	Path         string
+	Path         string // google.api.http路径模板, 由transport/http转换为gin路由
 	Method       string
 	HasVars      bool
 	HasBody      bool
-	HasQuery     bool
+	Body         string
+	BodyField    string
 	ResponseBody string
 }
 
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/go-slark/slark/config/secret"
+	"github.com/spf13/cobra"
+	"os"
+	"strings"
+)
+
+var key string
+
+var ConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "encrypt / decrypt config value",
+	Long:  "encrypt / decrypt config value, key file: aes keyring or age identity",
+}
+
+var encryptCmd = &cobra.Command{
+	Use:   "encrypt [value]",
+	Short: "encrypt config value to ENC(...)",
+	Long:  "encrypt config value to ENC(...), read from stdin if value is empty",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := secret.Load(key)
+		if err != nil {
+			return err
+		}
+		value, err := input(args)
+		if err != nil {
+			return err
+		}
+		text, err := c.Encrypt(value)
+		if err != nil {
+			return err
+		}
+		fmt.Println(secret.Wrap(text))
+		return nil
+	},
+}
+
+var decryptCmd = &cobra.Command{
+	Use:   "decrypt [ENC(...)]",
+	Short: "decrypt ENC(...) config value",
+	Long:  "decrypt ENC(...) config value, read from stdin if value is empty",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := secret.Load(key)
+		if err != nil {
+			return err
+		}
+		value, err := input(args)
+		if err != nil {
+			return err
+		}
+		text, ok := secret.Unwrap(value)
+		if !ok {
+			text = strings.TrimSpace(value)
+		}
+		plain, err := c.Decrypt(text)
+		if err != nil {
+			return err
+		}
+		fmt.Println(plain)
+		return nil
+	},
+}
+
+var keygenCmd = &cobra.Command{
+	Use:   "keygen [id]",
+	Short: "generate aes keyring line",
+	Long:  "generate aes keyring line: id:base64(32 bytes)",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := "default"
+		if len(args) > 0 {
+			id = args[0]
+		}
+		k, err := secret.GenerateKey()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s:%s\n", id, k)
+		return nil
+	},
+}
+
+func init() {
+	ConfigCmd.PersistentFlags().StringVarP(&key, "key", "k", os.Getenv("SLARK_CONFIG_KEY"), "key file, default $SLARK_CONFIG_KEY")
+	ConfigCmd.AddCommand(encryptCmd, decryptCmd, keygenCmd)
+}
+
+func input(args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	value, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && len(value) == 0 {
+		return "", errors.New("config value empty")
+	}
+	return strings.TrimRight(value, "\r\n"), nil
+}
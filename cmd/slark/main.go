@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"github.com/go-slark/slark/cmd/slark/config"
 	"github.com/go-slark/slark/cmd/slark/proto"
 	"github.com/spf13/cobra"
 )
@@ -15,6 +16,7 @@ var rootCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(proto.CreateCmd)
 	rootCmd.AddCommand(proto.InstallCmd)
+	rootCmd.AddCommand(config.ConfigCmd)
 }
 
 func main() {
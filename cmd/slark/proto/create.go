@@ -25,8 +25,7 @@ var CreateCmd = &cobra.Command{
 
 		plugins := []string{
 			"protoc-gen-go", "protoc-gen-go-grpc",
-			"protoc-gen-http", "protoc-gen-openapi",
-			"protoc-gen-validate", "protoc-gen-errors",
+			"protoc-gen-http", "protoc-gen-validate", "protoc-gen-errors",
 			"wire", "statik", "yq", "protoc-go-inject-tag",
 		}
 		err := find(plugins...)
@@ -106,11 +105,9 @@ func create(path, dir string) error {
 		"--go-grpc_out=" + dir,
 		"--go-grpc_opt=paths=source_relative",
 		"--http_out=" + dir,
-		"--http_opt=paths=source_relative",
+		"--http_opt=paths=source_relative,openapi=true",
 		"--errors_out=" + dir,
 		"--errors_opt=paths=source_relative",
-		"--openapi_out=" + dir,
-		"--openapi_opt=output_mode=source_relative,naming=proto,fq_schema_naming=true,default_response=false",
 	}
 	protoBytes, err := os.ReadFile(path)
 	if err == nil && len(protoBytes) > 0 {
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"github.com/go-slark/slark/config/secret"
 	"github.com/go-slark/slark/config/source/env"
 	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/logger"
 	"github.com/go-slark/slark/pkg/routine"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cast"
@@ -10,15 +12,28 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 )
 
 type Config struct {
-	l         sync.RWMutex
-	changed   map[string]any
-	cached    sync.Map
-	callback  []func()
-	delimiter string
-	src       Source
+	l          sync.RWMutex
+	changed    map[string]any
+	cached     sync.Map
+	callback   []func()
+	delimiter  string
+	src        []Source
+	layers     []map[string]any
+	overrides  map[string]any
+	origin     map[string]string
+	watchers   []*watcher
+	validators []func(map[string]any) error
+	cipher     secret.Cipher
+	secrets    map[string]struct{}
+}
+
+type watcher struct {
+	key string
+	fn  func(old, new any)
 }
 
 func New(opts ...Option) *Config {
@@ -27,12 +42,17 @@ func New(opts ...Option) *Config {
 		l:         sync.RWMutex{},
 		cached:    sync.Map{},
 		delimiter: ".",
-		src:       env.New(),
 		callback:  make([]func(), 0),
+		overrides: make(map[string]any),
+		origin:    make(map[string]string),
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
+	if len(c.src) == 0 {
+		c.src = []Source{env.New()}
+	}
+	c.layers = make([]map[string]any, len(c.src))
 	return c
 }
 
@@ -44,68 +64,166 @@ func Callback(callback []func()) Option {
 	}
 }
 
-func WithSource(src Source) Option {
+// WithSource 配置源按顺序合并, 靠后的优先级更高, 如 file -> apollo -> env
+func WithSource(src ...Source) Option {
 	return func(c *Config) {
-		c.src = src
+		c.src = append(c.src, src...)
+	}
+}
+
+// Validator 校验合并后的完整配置, 校验失败时拒绝本次加载并保留上一次的配置
+func Validator(validators ...func(map[string]any) error) Option {
+	return func(c *Config) {
+		c.validators = append(c.validators, validators...)
 	}
 }
 
 func (c *Config) Load() error {
-	cfg, err := c.src.Load()
-	if err != nil {
-		return err
+	for i, src := range c.src {
+		cfg, err := c.read(src)
+		if err != nil {
+			return err
+		}
+		c.layers[i] = cfg
 	}
-	err = c.load(cfg)
+	err := c.apply(-1, nil)
 	if err != nil {
 		return err
 	}
-	routine.GoSafe(context.TODO(), func() {
-		c.l.RLock()
-		for _, callback := range c.callback {
-			callback()
-		}
-		c.l.RUnlock()
-		for range c.src.Watch() {
-			cfg, err = c.src.Load()
-			if err != nil {
-				continue
+	c.notify()
+	// 每个配置源独立监听, 变化时只重新加载该配置源
+	for i, src := range c.src {
+		index, source := i, src
+		routine.GoSafe(context.TODO(), func() {
+			for range source.Watch() {
+				cfg, e := c.read(source)
+				if e != nil {
+					logger.Log(context.TODO(), logger.WarnLevel, map[string]interface{}{"error": e, "source": name(index, source)}, "config source load error")
+					continue
+				}
+				e = c.apply(index, cfg)
+				if e != nil {
+					logger.Log(context.TODO(), logger.WarnLevel, map[string]interface{}{"error": e, "source": name(index, source)}, "config reload rejected, keep last snapshot")
+					continue
+				}
+				c.notify()
 			}
-			_ = c.load(cfg)
-			c.l.RLock()
-			for _, callback := range c.callback {
-				callback()
-			}
-			c.l.RUnlock()
-		}
-	})
+		})
+	}
 	return nil
 }
 
-func (c *Config) load(data []byte) error {
-	cfg := make(map[string]any)
-	err := encoding.GetCodec(c.src.Format()).Unmarshal(data, &cfg)
+func (c *Config) notify() {
+	c.l.RLock()
+	callback := append([]func(){}, c.callback...)
+	c.l.RUnlock()
+	for _, fn := range callback {
+		fn()
+	}
+}
+
+func (c *Config) read(src Source) (map[string]any, error) {
+	data, err := src.Load()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	c.apply(cfg)
-	return nil
+	cfg := make(map[string]any)
+	if len(data) == 0 {
+		return cfg, nil
+	}
+	err = encoding.GetCodec(src.Format()).Unmarshal(data, &cfg)
+	return cfg, err
 }
 
-func (c *Config) apply(cfg map[string]any) {
+// apply 替换第index个配置源的数据(index < 0时只重新合并), 按优先级合并后展开占位符并解密
+func (c *Config) apply(index int, cfg map[string]any) error {
 	c.l.Lock()
-	defer c.l.Unlock()
+	layers := c.layers
+	if index >= 0 {
+		layers = append([]map[string]any(nil), c.layers...)
+		layers[index] = cfg
+	}
+	// 在副本上合并及校验, 失败时保留原配置
+	next := make(map[string]any)
+	origin := make(map[string]string)
+	for i, layer := range layers {
+		for k := range spread(layer, "", c.delimiter) {
+			origin[k] = name(i, c.src[i])
+		}
+		merge(next, clone(layer))
+	}
+	for k := range spread(c.overrides, "", c.delimiter) {
+		origin[k] = "override"
+	}
+	merge(next, clone(c.overrides))
+	expand(next, c.delimiter)
+	secrets, err := decrypt(next, c.cipher, c.delimiter)
+	if err != nil {
+		c.l.Unlock()
+		return err
+	}
+	for _, validate := range c.validators {
+		err = validate(next)
+		if err != nil {
+			c.l.Unlock()
+			return err
+		}
+	}
+	c.layers = layers
+	c.origin = origin
+	c.secrets = secrets
+	prev := c.changed
+	c.changed = next
+	old := spread(prev, "", c.delimiter)
+	data := spread(next, "", c.delimiter)
 	changes := make(map[string]any)
-	merge(c.changed, cfg)
-	data := spread(c.changed, "", c.delimiter)
 	for k, v := range data {
-		vv, ok := c.cached.Load(k)
-		if ok && !reflect.DeepEqual(vv, v) {
+		vv, ok := old[k]
+		if !ok || !reflect.DeepEqual(vv, v) {
 			changes[k] = v
 		}
+	}
+	for k := range old {
+		if _, ok := data[k]; !ok {
+			changes[k] = nil
+		}
+	}
+	c.cached.Range(func(k, _ any) bool {
+		c.cached.Delete(k)
+		return true
+	})
+	for k, v := range data {
 		c.cached.Store(k, v)
 	}
-	if len(changes) > 0 {
-		// TODO
+	watchers := append([]*watcher(nil), c.watchers...)
+	c.l.Unlock()
+	if len(changes) == 0 {
+		return nil
+	}
+	for _, w := range watchers {
+		o, n := lookup(prev, w.key, c.delimiter), lookup(next, w.key, c.delimiter)
+		if !reflect.DeepEqual(o, n) {
+			w.fn(o, n)
+		}
+	}
+	return nil
+}
+
+// Watch key及其子key变化时回调, 首次加载时old为nil; 返回取消函数
+func (c *Config) Watch(key string, fn func(old, new any)) func() {
+	w := &watcher{key: key, fn: fn}
+	c.l.Lock()
+	c.watchers = append(c.watchers, w)
+	c.l.Unlock()
+	return func() {
+		c.l.Lock()
+		defer c.l.Unlock()
+		for i, v := range c.watchers {
+			if v == w {
+				c.watchers = append(c.watchers[:i], c.watchers[i+1:]...)
+				return
+			}
+		}
 	}
 }
 
@@ -142,12 +260,15 @@ func (c *Config) Unmarshal(v any, key ...string) error {
 	return decoder.Decode(c.find(key[0]))
 }
 
-func (c *Config) set(key, value string) {
+// set 优先级最高的覆盖值
+func (c *Config) set(key, value string) error {
 	paths := strings.Split(key, c.delimiter)
 	lastKey := paths[len(paths)-1]
-	m := search(c.changed, paths[:len(paths)-1])
+	c.l.Lock()
+	m := search(c.overrides, paths[:len(paths)-1])
 	m[lastKey] = value
-	c.apply(m)
+	c.l.Unlock()
+	return c.apply(-1, nil)
 }
 
 func (c *Config) Get(key string) any {
@@ -157,3 +278,41 @@ func (c *Config) Get(key string) any {
 func (c *Config) GetString(key string) string {
 	return cast.ToString(c.Get(key))
 }
+
+func (c *Config) GetInt(key string) int {
+	return cast.ToInt(c.Get(key))
+}
+
+func (c *Config) GetInt64(key string) int64 {
+	return cast.ToInt64(c.Get(key))
+}
+
+func (c *Config) GetFloat64(key string) float64 {
+	return cast.ToFloat64(c.Get(key))
+}
+
+func (c *Config) GetBool(key string) bool {
+	return cast.ToBool(c.Get(key))
+}
+
+// GetDuration 支持"5s"格式字符串, 整数按纳秒处理
+func (c *Config) GetDuration(key string) time.Duration {
+	return cast.ToDuration(c.Get(key))
+}
+
+// GetStringSlice 字符串按逗号分隔, 兼容env / properties
+func (c *Config) GetStringSlice(key string) []string {
+	v := c.Get(key)
+	str, ok := v.(string)
+	if !ok {
+		return cast.ToStringSlice(v)
+	}
+	if len(str) == 0 {
+		return nil
+	}
+	ss := strings.Split(str, ",")
+	for i := range ss {
+		ss[i] = strings.TrimSpace(ss[i])
+	}
+	return ss
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"github.com/go-slark/slark/config/secret"
+)
+
+const mask = "******"
+
+// Decrypt 配置值为ENC(...)时加载后透明解密, 解密失败时拒绝本次加载
+func Decrypt(cipher secret.Cipher) Option {
+	return func(c *Config) {
+		c.cipher = cipher
+	}
+}
+
+// decrypt 占位符展开后解密, 返回解密过的key
+func decrypt(m map[string]any, cipher secret.Cipher, delimiter string) (map[string]struct{}, error) {
+	keys := make(map[string]struct{})
+	if cipher == nil {
+		return keys, nil
+	}
+	var walk func(path string, v any) (any, error)
+	walk = func(path string, v any) (any, error) {
+		switch vv := v.(type) {
+		case string:
+			text, ok := secret.Unwrap(vv)
+			if !ok {
+				return v, nil
+			}
+			plain, err := cipher.Decrypt(text)
+			if err != nil {
+				return nil, fmt.Errorf("config decrypt %s: %w", path, err)
+			}
+			keys[path] = struct{}{}
+			return plain, nil
+		case map[string]any:
+			for k, sv := range vv {
+				nv, err := walk(join(path, k, delimiter), sv)
+				if err != nil {
+					return nil, err
+				}
+				vv[k] = nv
+			}
+		case map[any]any:
+			for k, sv := range vv {
+				nv, err := walk(join(path, fmt.Sprintf("%v", k), delimiter), sv)
+				if err != nil {
+					return nil, err
+				}
+				vv[k] = nv
+			}
+		case []any:
+			for i, sv := range vv {
+				nv, err := walk(path, sv)
+				if err != nil {
+					return nil, err
+				}
+				vv[i] = nv
+			}
+		}
+		return v, nil
+	}
+	for k, v := range m {
+		nv, err := walk(k, v)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = nv
+	}
+	return keys, nil
+}
+
+func join(prefix, key, delimiter string) string {
+	if len(prefix) == 0 {
+		return key
+	}
+	return prefix + delimiter + key
+}
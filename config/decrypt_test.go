@@ -0,0 +1,97 @@
+package config
+
+import (
+	"github.com/go-slark/slark/config/secret"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecrypt(t *testing.T) {
+	key, _ := secret.GenerateKey()
+	k, err := secret.ParseKeyring(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dsn, _ := k.Encrypt("root:pass@tcp(db:3306)/app")
+	pwd, _ := k.Encrypt("redis-pass")
+	t.Setenv("SLARK_TEST_REDIS_PASSWORD", secret.Wrap(pwd))
+	src := &source{
+		data:   `{"mysql":{"address":"` + secret.Wrap(dsn) + `"},"redis":{"password":"${SLARK_TEST_REDIS_PASSWORD}"},"name":"app"}`,
+		notify: make(chan struct{}),
+	}
+	c := New(WithSource(src), Decrypt(k))
+	err = c.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetString("mysql.address") != "root:pass@tcp(db:3306)/app" || c.GetString("redis.password") != "redis-pass" {
+		t.Fatalf("decrypt: %v %v", c.Get("mysql.address"), c.Get("redis.password"))
+	}
+	dump := c.Dump()
+	if strings.Contains(dump, "pass@") || !strings.Contains(dump, "mysql.address = ******") || !strings.Contains(dump, "name = app") {
+		t.Fatalf("dump:\n%s", dump)
+	}
+
+	// 无法解密时拒绝加载
+	c = New(WithSource(&source{data: `{"jwt":{"key":"ENC(aes:default:AAAA)"}}`, notify: make(chan struct{})}), Decrypt(k))
+	if err = c.Load(); err == nil || !strings.Contains(err.Error(), "jwt.key") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestDecryptList(t *testing.T) {
+	key, _ := secret.GenerateKey()
+	k, err := secret.ParseKeyring(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, _ := k.Encrypt("topsecret")
+	base := &source{data: `{"redis":{"addrs":["` + secret.Wrap(addr) + `"]}}`, notify: make(chan struct{})}
+	remote := &source{data: `{"name":"app"}`, notify: make(chan struct{})}
+	c := New(WithSource(base, remote), Decrypt(k))
+	if err = c.Load(); err != nil {
+		t.Fatal(err)
+	}
+	changed := make(chan any, 1)
+	c.Watch("name", func(_, new any) {
+		changed <- new
+	})
+
+	// 其他配置源重新加载后, 列表中的密文仍需解密且脱敏
+	remote.update(`{"name":"app2"}`)
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("reload not applied")
+	}
+	if addrs := c.GetStringSlice("redis.addrs"); len(addrs) != 1 || addrs[0] != "topsecret" {
+		t.Fatalf("decrypt list: %v", c.Get("redis.addrs"))
+	}
+	if dump := c.Dump(); strings.Contains(dump, "topsecret") || !strings.Contains(dump, "redis.addrs = ******") {
+		t.Fatalf("dump:\n%s", dump)
+	}
+}
+
+func TestDumpListOfMaps(t *testing.T) {
+	key, _ := secret.GenerateKey()
+	k, err := secret.ParseKeyring(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pass, _ := k.Encrypt("hunter2")
+	c := New(WithSource(&source{data: `{"dbs":[{"name":"a","pass":"` + secret.Wrap(pass) + `"}]}`, notify: make(chan struct{})}), Decrypt(k))
+	if err = c.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if dump := c.Dump(); strings.Contains(dump, "hunter2") || !strings.Contains(dump, "dbs = [map[name:a pass:******]]") {
+		t.Fatalf("dump:\n%s", dump)
+	}
+	if entries := c.Entries(); len(entries) != 1 || !entries[0].Secret {
+		t.Fatalf("entries: %v", entries)
+	}
+	// 掩码返回副本, 不修改生效配置
+	if dbs, _ := c.Get("dbs").([]any); len(dbs) != 1 || dbs[0].(map[string]any)["pass"] != "hunter2" {
+		t.Fatalf("dbs: %v", c.Get("dbs"))
+	}
+}
@@ -0,0 +1,160 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ${key} 引用其他配置项, ${ENV:default} 引用环境变量, 均未找到时使用默认值
+var placeholder = regexp.MustCompile(`\$\{([^{}]+)\}`)
+
+const maxDepth = 8
+
+func expand(m map[string]any, delimiter string) {
+	flat := spread(m, "", delimiter)
+	var walk func(v any) any
+	walk = func(v any) any {
+		switch vv := v.(type) {
+		case string:
+			return resolve(vv, flat, 0)
+		case map[string]any:
+			for k, sv := range vv {
+				vv[k] = walk(sv)
+			}
+		case map[any]any:
+			for k, sv := range vv {
+				vv[k] = walk(sv)
+			}
+		case []any:
+			for i, sv := range vv {
+				vv[i] = walk(sv)
+			}
+		}
+		return v
+	}
+	for k, v := range m {
+		m[k] = walk(v)
+	}
+}
+
+func resolve(s string, flat map[string]any, depth int) any {
+	if depth > maxDepth || !strings.Contains(s, "${") {
+		return s
+	}
+	// 整个字符串为占位符时保留引用值的类型
+	if loc := placeholder.FindStringSubmatchIndex(s); loc != nil && loc[0] == 0 && loc[1] == len(s) {
+		return lookupPlaceholder(s[loc[2]:loc[3]], flat, depth)
+	}
+	return placeholder.ReplaceAllStringFunc(s, func(match string) string {
+		return fmt.Sprintf("%v", lookupPlaceholder(match[2:len(match)-1], flat, depth))
+	})
+}
+
+func lookupPlaceholder(expr string, flat map[string]any, depth int) any {
+	key, def, _ := strings.Cut(expr, ":")
+	key = strings.TrimSpace(key)
+	if v, ok := flat[key]; ok {
+		if str, ok := v.(string); ok {
+			return resolve(str, flat, depth+1)
+		}
+		return v
+	}
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return def
+}
+
+// Entry 生效的配置项, Secret为true时Value为或包含(列表/map中)解密后的明文
+type Entry struct {
+	Key    string `json:"key"`
+	Value  any    `json:"value"`
+	Source string `json:"source"`
+	Secret bool   `json:"secret"`
+}
+
+// Entries 按key排序的生效配置
+func (c *Config) Entries() []Entry {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	data := spread(c.changed, "", c.delimiter)
+	entries := make([]Entry, 0, len(data))
+	for k, v := range data {
+		entries = append(entries, Entry{Key: k, Value: v, Source: c.origin[k], Secret: c.secret(k)})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
+}
+
+// MaskedEntries 掩码后的生效配置, 解密过的值及masked命中的key替换为******, 列表/map按子路径逐项处理, masked可为nil
+func (c *Config) MaskedEntries(masked func(key string) bool) []Entry {
+	entries := c.Entries()
+	c.l.RLock()
+	defer c.l.RUnlock()
+	for i := range entries {
+		entries[i].Value = c.mask(entries[i].Key, entries[i].Value, masked)
+	}
+	return entries
+}
+
+// secret key本身或其下(列表中的map)有解密过的值
+func (c *Config) secret(key string) bool {
+	if _, ok := c.secrets[key]; ok {
+		return true
+	}
+	for k := range c.secrets {
+		if strings.HasPrefix(k, key+c.delimiter) {
+			return true
+		}
+	}
+	return false
+}
+
+// mask 返回掩码后的副本, 列表元素沿用列表的路径, 与decrypt记录的路径一致
+func (c *Config) mask(path string, v any, masked func(key string) bool) any {
+	if _, ok := c.secrets[path]; ok || (masked != nil && masked(path)) {
+		return mask
+	}
+	switch vv := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(vv))
+		for k, sv := range vv {
+			m[k] = c.mask(join(path, k, c.delimiter), sv, masked)
+		}
+		return m
+	case map[any]any:
+		m := make(map[any]any, len(vv))
+		for k, sv := range vv {
+			m[k] = c.mask(join(path, fmt.Sprintf("%v", k), c.delimiter), sv, masked)
+		}
+		return m
+	case []any:
+		l := make([]any, len(vv))
+		for i, sv := range vv {
+			l[i] = c.mask(path, sv, masked)
+		}
+		return l
+	}
+	return v
+}
+
+// Dump 生效的配置及其来源, 格式: key = value (source), 解密后的值不输出
+func (c *Config) Dump() string {
+	var b strings.Builder
+	for _, e := range c.MaskedEntries(nil) {
+		_, _ = fmt.Fprintf(&b, "%s = %v (%s)\n", e.Key, e.Value, e.Source)
+	}
+	return b.String()
+}
+
+// Origin key生效值所在的配置源
+func (c *Config) Origin(key string) string {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	return c.origin[key]
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"github.com/go-slark/slark/config/source/env"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLayeredSources(t *testing.T) {
+	t.Setenv("slark_redis__addr", "10.0.0.1:6379")
+	t.Setenv("SLARK_TEST_HOST", "db.local")
+	base := &source{
+		data:   `{"redis":{"addr":"127.0.0.1:6379","db":1},"mysql":{"host":"${SLARK_TEST_HOST:localhost}","port":3306,"dsn":"${mysql.host}:${mysql.port}","user":"${MYSQL_USER_UNSET:root}"},"port":"${mysql.port}"}`,
+		notify: make(chan struct{}),
+	}
+	remote := &source{
+		data:   `{"redis":{"db":2}}`,
+		notify: make(chan struct{}),
+	}
+	c := New(WithSource(base, remote, env.New(env.Separator("__"))))
+	err := c.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetString("redis.addr") != "10.0.0.1:6379" || c.GetInt("redis.db") != 2 {
+		t.Fatalf("precedence: %v %v", c.Get("redis.addr"), c.Get("redis.db"))
+	}
+	if c.GetString("mysql.dsn") != "db.local:3306" || c.GetString("mysql.user") != "root" {
+		t.Fatalf("expand: %v %v", c.Get("mysql.dsn"), c.Get("mysql.user"))
+	}
+	if c.GetInt("port") != 3306 {
+		t.Fatalf("typed placeholder: %#v", c.Get("port"))
+	}
+	if c.Origin("redis.addr") != "env" || !strings.Contains(c.Dump(), "redis.db = 2 (1:*config.source)") {
+		t.Fatalf("dump:\n%s", c.Dump())
+	}
+
+	// 只重新加载变化的配置源
+	changed := make(chan any, 1)
+	c.Watch("redis.db", func(_, new any) {
+		changed <- new
+	})
+	remote.update(`{"redis":{"db":3}}`)
+	select {
+	case v := <-changed:
+		if c.GetInt("redis.db") != 3 {
+			t.Fatalf("reload: %v", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("per-source reload not applied")
+	}
+	remote.update(`{}`)
+	select {
+	case <-changed:
+		if c.GetInt("redis.db") != 1 || c.Origin("redis.db") != "0:*config.source" {
+			t.Fatalf("fallback to lower layer: %v %s", c.Get("redis.db"), c.Origin("redis.db"))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("removed key not reloaded")
+	}
+}
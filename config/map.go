@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/spf13/cast"
 	"reflect"
+	"strings"
 )
 
 func convert(mp map[any]any) map[string]any {
@@ -14,7 +15,7 @@ func convert(mp map[any]any) map[string]any {
 	return m
 }
 
-// 配置文件层级合并，key冲突时由读取顺序决定覆盖顺序(避免key重复)
+// 配置文件层级合并，key冲突时后读取的覆盖先读取的
 func merge(dest, src map[string]any) {
 	for sk, sv := range src {
 		tv, ok := dest[sk]
@@ -24,6 +25,16 @@ func merge(dest, src map[string]any) {
 		}
 
 		if reflect.TypeOf(sv) != reflect.TypeOf(tv) {
+			// 优先级高的配置源覆盖, 两侧均为map时继续合并
+			ssv, serr := cast.ToStringMapE(sv)
+			stv, terr := cast.ToStringMapE(tv)
+			if serr == nil && terr == nil {
+				stv = clone(stv)
+				merge(stv, ssv)
+				dest[sk] = stv
+			} else {
+				dest[sk] = sv
+			}
 			continue
 		}
 
@@ -103,3 +114,53 @@ func spread(src map[string]any, prefix, delimiter string) map[string]any {
 	}
 	return target
 }
+
+// clone 深拷贝嵌套map及列表, 合并新配置前使用, 避免展开/解密时修改当前快照及各层配置
+func clone(src map[string]any) map[string]any {
+	dest := make(map[string]any, len(src))
+	for k, v := range src {
+		dest[k] = cloneValue(v)
+	}
+	return dest
+}
+
+func cloneValue(v any) any {
+	switch vv := v.(type) {
+	case map[string]any:
+		return clone(vv)
+	case map[any]any:
+		m := make(map[any]any, len(vv))
+		for k, sv := range vv {
+			m[k] = cloneValue(sv)
+		}
+		return m
+	case []any:
+		l := make([]any, len(vv))
+		for i, sv := range vv {
+			l[i] = cloneValue(sv)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+// lookup 按分隔符逐级查找, key为空时返回整个map
+func lookup(m map[string]any, key, delimiter string) any {
+	if len(key) == 0 {
+		return m
+	}
+	var v any = m
+	for _, path := range strings.Split(key, delimiter) {
+		mp, err := cast.ToStringMapE(v)
+		if err != nil {
+			return nil
+		}
+		next, ok := mp[path]
+		if !ok {
+			return nil
+		}
+		v = next
+	}
+	return v
+}
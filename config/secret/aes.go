@@ -0,0 +1,128 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	aesScheme  = "aes:"
+	defaultKey = "default"
+)
+
+// Keyring AES-256-GCM, 第一个key用于加密, 其余key只用于解密, 便于密钥轮换
+type Keyring struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// GenerateKey 随机生成32字节key, base64编码
+func GenerateKey() (string, error) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+/*
+ParseKeyring 每行一个key, #开头为注释:
+# id:base64(32 bytes)
+v2:3q2+7w...
+v1:q83v...
+*/
+func ParseKeyring(content string) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string]cipher.AEAD)}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, key, ok := strings.Cut(line, ":")
+		if !ok {
+			id, key = defaultKey, line
+		}
+		err := k.Add(strings.TrimSpace(id), strings.TrimSpace(key))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(k.keys) == 0 {
+		return nil, errors.New("secret keyring empty")
+	}
+	return k, nil
+}
+
+// Add key为base64编码的32字节密钥
+func (k *Keyring) Add(id, key string) error {
+	if strings.Contains(id, ":") {
+		return fmt.Errorf("secret key id %s invalid", id)
+	}
+	if _, ok := k.keys[id]; ok {
+		return fmt.Errorf("secret key id %s duplicate", id)
+	}
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return err
+	}
+	if len(raw) != 32 {
+		return fmt.Errorf("secret key %s must be 32 bytes", id)
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	if len(k.primary) == 0 {
+		k.primary = id
+	}
+	k.keys[id] = aead
+	return nil
+}
+
+func (k *Keyring) Encrypt(plain string) (string, error) {
+	aead := k.keys[k.primary]
+	nonce := make([]byte, aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return "", err
+	}
+	// key id作为附加数据, 防止替换key id
+	data := aead.Seal(nonce, nonce, []byte(plain), []byte(k.primary))
+	return aesScheme + k.primary + ":" + base64.StdEncoding.EncodeToString(data), nil
+}
+
+func (k *Keyring) Decrypt(text string) (string, error) {
+	if !strings.HasPrefix(text, aesScheme) {
+		return "", ErrScheme
+	}
+	id, payload, ok := strings.Cut(strings.TrimPrefix(text, aesScheme), ":")
+	if !ok {
+		return "", errors.New("secret aes cipher text invalid")
+	}
+	aead, ok := k.keys[id]
+	if !ok {
+		return "", fmt.Errorf("secret key id %s not found", id)
+	}
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < aead.NonceSize() {
+		return "", errors.New("secret aes cipher text invalid")
+	}
+	plain, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(id))
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
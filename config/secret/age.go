@@ -0,0 +1,79 @@
+package secret
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"filippo.io/age"
+	"io"
+	"strings"
+)
+
+const ageScheme = "age:"
+
+// Age 使用age加解密, 可只配置recipient用于加密
+type Age struct {
+	identities []age.Identity
+	recipients []age.Recipient
+}
+
+func NewAge(identities []age.Identity, recipients ...age.Recipient) *Age {
+	return &Age{identities: identities, recipients: recipients}
+}
+
+// ParseAge 解析age-keygen生成的identity文件, 加密时使用identity对应的recipient
+func ParseAge(content string) (*Age, error) {
+	identities, err := age.ParseIdentities(strings.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	a := &Age{identities: identities}
+	for _, identity := range identities {
+		if x, ok := identity.(*age.X25519Identity); ok {
+			a.recipients = append(a.recipients, x.Recipient())
+		}
+	}
+	return a, nil
+}
+
+func (a *Age) Encrypt(plain string) (string, error) {
+	if len(a.recipients) == 0 {
+		return "", errors.New("secret age recipient empty")
+	}
+	buf := &bytes.Buffer{}
+	w, err := age.Encrypt(buf, a.recipients...)
+	if err != nil {
+		return "", err
+	}
+	_, err = io.WriteString(w, plain)
+	if err != nil {
+		return "", err
+	}
+	err = w.Close()
+	if err != nil {
+		return "", err
+	}
+	return ageScheme + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func (a *Age) Decrypt(text string) (string, error) {
+	if !strings.HasPrefix(text, ageScheme) {
+		return "", ErrScheme
+	}
+	if len(a.identities) == 0 {
+		return "", errors.New("secret age identity empty")
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(text, ageScheme))
+	if err != nil {
+		return "", err
+	}
+	r, err := age.Decrypt(bytes.NewReader(data), a.identities...)
+	if err != nil {
+		return "", err
+	}
+	plain, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
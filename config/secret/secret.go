@@ -0,0 +1,74 @@
+package secret
+
+import (
+	"errors"
+	"os"
+	"strings"
+)
+
+// 配置中的加密值格式: ENC(<scheme>:<payload>)
+// aes: ENC(aes:<key id>:<base64(nonce+ciphertext)>)
+// age: ENC(age:<base64(age binary)>)
+
+const (
+	prefix = "ENC("
+	suffix = ")"
+)
+
+var ErrScheme = errors.New("secret scheme not supported")
+
+// Cipher 加解密单个配置值, 密文不含ENC()
+type Cipher interface {
+	Encrypt(plain string) (string, error)
+	Decrypt(text string) (string, error)
+}
+
+// Wrap 密文包装为ENC(...)
+func Wrap(text string) string {
+	return prefix + text + suffix
+}
+
+// Unwrap 去掉ENC(...), 非加密值返回false
+func Unwrap(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, prefix) || !strings.HasSuffix(value, suffix) {
+		return "", false
+	}
+	return value[len(prefix) : len(value)-len(suffix)], true
+}
+
+type multi []Cipher
+
+// Multi 第一个用于加密, 解密时按scheme依次尝试
+func Multi(ciphers ...Cipher) Cipher {
+	return multi(ciphers)
+}
+
+func (m multi) Encrypt(plain string) (string, error) {
+	if len(m) == 0 {
+		return "", ErrScheme
+	}
+	return m[0].Encrypt(plain)
+}
+
+func (m multi) Decrypt(text string) (string, error) {
+	for _, c := range m {
+		plain, err := c.Decrypt(text)
+		if !errors.Is(err, ErrScheme) {
+			return plain, err
+		}
+	}
+	return "", ErrScheme
+}
+
+// Load 读取本地密钥文件, age-keygen生成的identity文件使用age, 否则按aes keyring解析
+func Load(path string) (Cipher, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(string(data), "AGE-SECRET-KEY-") {
+		return ParseAge(string(data))
+	}
+	return ParseKeyring(string(data))
+}
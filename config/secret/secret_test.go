@@ -0,0 +1,85 @@
+package secret
+
+import (
+	"filippo.io/age"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestKeyring(t *testing.T) {
+	v1, _ := GenerateKey()
+	v2, _ := GenerateKey()
+	old, err := ParseKeyring("v1:" + v1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := old.Encrypt("root:pass@tcp(db:3306)/app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 轮换后新key加密, 旧key仍可解密
+	k, err := ParseKeyring("# rotated\nv2:" + v2 + "\nv1:" + v1 + "\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain, err := k.Decrypt(text)
+	if err != nil || plain != "root:pass@tcp(db:3306)/app" {
+		t.Fatalf("decrypt %q %v", plain, err)
+	}
+	text, _ = k.Encrypt("x")
+	if !strings.HasPrefix(text, "aes:v2:") {
+		t.Fatalf("primary key not used: %s", text)
+	}
+	if _, err = old.Decrypt(text); err == nil {
+		t.Fatal("unknown key id decrypted")
+	}
+	// 篡改key id
+	if _, err = k.Decrypt(strings.Replace(text, "aes:v2:", "aes:v1:", 1)); err == nil {
+		t.Fatal("tampered key id decrypted")
+	}
+	if _, err = k.Decrypt("age:xxx"); err != ErrScheme {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	agePath := filepath.Join(dir, "age.key")
+	_ = os.WriteFile(agePath, []byte("# public key: "+identity.Recipient().String()+"\n"+identity.String()+"\n"), 0600)
+	key, _ := GenerateKey()
+	aesPath := filepath.Join(dir, "aes.key")
+	_ = os.WriteFile(aesPath, []byte(key+"\n"), 0600)
+
+	a, err := Load(agePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := Load(aesPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Multi(k, a)
+	for _, cipher := range []Cipher{a, k} {
+		text, e := cipher.Encrypt("secret")
+		if e != nil {
+			t.Fatal(e)
+		}
+		wrapped, ok := Unwrap(Wrap(text))
+		if !ok {
+			t.Fatal("unwrap failed")
+		}
+		plain, e := c.Decrypt(wrapped)
+		if e != nil || plain != "secret" {
+			t.Fatalf("decrypt %q %v", plain, e)
+		}
+	}
+	if _, ok := Unwrap("plain"); ok {
+		t.Fatal("plain value unwrapped")
+	}
+}
@@ -1,8 +1,22 @@
 package config
 
+import "fmt"
+
 type Source interface {
 	Load() ([]byte, error)
 	Watch() <-chan struct{}
 	Close() error
 	Format() string
 }
+
+// Named 可选, 配置源名称, 用于Dump中标识配置来源
+type Named interface {
+	Name() string
+}
+
+func name(index int, src Source) string {
+	if n, ok := src.(Named); ok {
+		return n.Name()
+	}
+	return fmt.Sprintf("%d:%T", index, src)
+}
@@ -1,33 +1,89 @@
 package apollo
 
 import (
+	"github.com/go-slark/slark/config/source"
+	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/encoding/json"
 	"github.com/go-slark/slark/encoding/properties"
 	"github.com/philchia/agollo/v4"
+	"sync"
 )
 
+// Client agollo.Client的子集, 便于替换
+type Client interface {
+	OnUpdate(func(*agollo.ChangeEvent))
+	// Content namespace的完整内容, properties格式的namespace返回key=value文本
+	Content(namespace string) string
+	Stop() error
+}
+
+type client struct {
+	agollo.Client
+}
+
+func (c *client) Content(namespace string) string {
+	if format(namespace) == properties.Name {
+		return c.GetPropertiesContent(agollo.WithNamespace(namespace))
+	}
+	return c.GetContent(agollo.WithNamespace(namespace))
+}
+
+// Apollo 支持多个namespace, 按顺序合并, 靠后的namespace优先级更高
+// namespace格式由后缀决定: application -> properties, app.yaml -> yaml, app.json -> json
 type Apollo struct {
-	client    agollo.Client
-	namespace string
-	notify    chan struct{}
+	client     Client
+	namespaces []string
+	notify     chan struct{}
+	l          sync.Mutex
+	closed     bool
 }
 
 func New(c *agollo.Conf) *Apollo {
-	client := agollo.NewClient(c)
-	client.Start()
+	cli := agollo.NewClient(c)
+	_ = cli.Start()
+	namespaces := c.NameSpaceNames
+	if len(namespaces) == 0 {
+		namespaces = []string{"application"}
+	}
+	return NewWithClient(&client{Client: cli}, namespaces...)
+}
+
+func NewWithClient(c Client, namespaces ...string) *Apollo {
 	ap := &Apollo{
-		client:    client,
-		namespace: c.NameSpaceNames[0], // TODO
-		notify:    make(chan struct{}, 1),
+		client:     c,
+		namespaces: namespaces,
+		notify:     make(chan struct{}, 1),
 	}
-	client.OnUpdate(func(e *agollo.ChangeEvent) {
-		ap.notify <- struct{}{}
+	c.OnUpdate(func(e *agollo.ChangeEvent) {
+		// 非阻塞, 未消费的通知合并为一次
+		ap.l.Lock()
+		defer ap.l.Unlock()
+		if ap.closed {
+			return
+		}
+		select {
+		case ap.notify <- struct{}{}:
+		default:
+		}
 	})
 	return ap
 }
 
 func (a *Apollo) Load() ([]byte, error) {
-	cfg := a.client.GetContent(agollo.WithNamespace(a.namespace))
-	return []byte(cfg), nil
+	cfg := make(map[string]any)
+	for _, ns := range a.namespaces {
+		content := a.client.Content(ns)
+		if len(content) == 0 {
+			continue
+		}
+		mp := make(map[string]any)
+		err := encoding.GetCodec(format(ns)).Unmarshal([]byte(content), &mp)
+		if err != nil {
+			return nil, err
+		}
+		source.Merge(cfg, mp)
+	}
+	return encoding.GetCodec(json.Name).Marshal(cfg)
 }
 
 func (a *Apollo) Watch() <-chan struct{} {
@@ -35,9 +91,24 @@ func (a *Apollo) Watch() <-chan struct{} {
 }
 
 func (a *Apollo) Close() error {
-	return a.client.Stop()
+	err := a.client.Stop()
+	a.l.Lock()
+	defer a.l.Unlock()
+	if !a.closed {
+		a.closed = true
+		close(a.notify)
+	}
+	return err
 }
 
 func (a *Apollo) Format() string {
-	return properties.Name
+	return json.Name
+}
+
+func (a *Apollo) Name() string {
+	return "apollo"
+}
+
+func format(namespace string) string {
+	return source.Format(namespace, properties.Name)
 }
@@ -0,0 +1,68 @@
+package apollo
+
+import (
+	"github.com/go-slark/slark/encoding"
+	"github.com/philchia/agollo/v4"
+	"sync"
+	"testing"
+)
+
+type fake struct {
+	l       sync.Mutex
+	content map[string]string
+	fn      func(*agollo.ChangeEvent)
+}
+
+func (f *fake) OnUpdate(fn func(*agollo.ChangeEvent)) {
+	f.fn = fn
+}
+
+func (f *fake) Content(namespace string) string {
+	f.l.Lock()
+	defer f.l.Unlock()
+	return f.content[namespace]
+}
+
+func (f *fake) Stop() error {
+	return nil
+}
+
+func (f *fake) update(namespace, content string) {
+	f.l.Lock()
+	f.content[namespace] = content
+	f.l.Unlock()
+	f.fn(&agollo.ChangeEvent{Namespace: namespace})
+}
+
+func TestNamespaces(t *testing.T) {
+	f := &fake{content: map[string]string{
+		"application": "name=app\nredis.db=1",
+		"redis.yaml":  "redis:\n  addr: a\n",
+		"mysql.json":  `{"mysql":{"dsn":"x"},"name":"json"}`,
+	}}
+	ap := NewWithClient(f, "application", "redis.yaml", "mysql.json")
+	data, err := ap.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mp := make(map[string]any)
+	if err = encoding.GetCodec(ap.Format()).Unmarshal(data, &mp); err != nil {
+		t.Fatal(err)
+	}
+	if mp["name"] != "json" {
+		t.Fatalf("later namespace not win: %v", mp["name"])
+	}
+	if mp["redis"].(map[string]any)["addr"] != "a" || mp["mysql"].(map[string]any)["dsn"] != "x" {
+		t.Fatalf("namespace not merged: %v", mp)
+	}
+
+	// 通知未消费时不阻塞
+	f.update("redis.yaml", "redis:\n  addr: b\n")
+	f.update("redis.yaml", "redis:\n  addr: c\n")
+	<-ap.Watch()
+	_ = ap.Close()
+	f.update("redis.yaml", "redis:\n  addr: d\n")
+	if _, ok := <-ap.Watch(); ok {
+		t.Fatal("watch channel not closed")
+	}
+}
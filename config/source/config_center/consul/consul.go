@@ -0,0 +1,165 @@
+package consul
+
+import (
+	"context"
+	"github.com/go-slark/slark/config/source"
+	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/encoding/json"
+	"github.com/hashicorp/consul/api"
+	"sort"
+	"sync"
+	"time"
+)
+
+type Option func(*Consul)
+
+// Prefix 读取key前缀下的所有配置, 每个key按扩展名解码后按key顺序合并
+func Prefix(prefix bool) Option {
+	return func(c *Consul) {
+		c.prefix = prefix
+	}
+}
+
+// Format 单个key的编码, 默认按key扩展名, 无扩展名时为json
+func Format(format string) Option {
+	return func(c *Consul) {
+		c.format = format
+	}
+}
+
+// WaitTime blocking query等待时间
+func WaitTime(tm time.Duration) Option {
+	return func(c *Consul) {
+		c.wait = tm
+	}
+}
+
+type Consul struct {
+	client *api.Client
+	key    string
+	prefix bool
+	format string
+	wait   time.Duration
+	ctx    context.Context
+	cancel context.CancelFunc
+	notify chan struct{}
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+func New(client *api.Client, key string, opts ...Option) *Consul {
+	c := &Consul{
+		client: client,
+		key:    key,
+		format: source.Format(key, json.Name),
+		wait:   55 * time.Second,
+		notify: make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.wg.Add(1)
+	go c.watch()
+	return c
+}
+
+// watch blocking query, index变化时通知
+func (c *Consul) watch() {
+	defer c.wg.Done()
+	defer close(c.notify)
+	var index uint64
+	for {
+		opts := (&api.QueryOptions{WaitIndex: index, WaitTime: c.wait}).WithContext(c.ctx)
+		var (
+			meta *api.QueryMeta
+			err  error
+		)
+		if c.prefix {
+			_, meta, err = c.client.KV().List(c.key, opts)
+		} else {
+			_, meta, err = c.client.KV().Get(c.key, opts)
+		}
+		if c.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		prev := index
+		index = meta.LastIndex
+		if meta.LastIndex < prev {
+			// index回退时重置, 参考consul blocking query
+			index = 0
+		}
+		if prev == 0 || meta.LastIndex == prev {
+			continue
+		}
+		select {
+		case c.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (c *Consul) Load() ([]byte, error) {
+	opts := (&api.QueryOptions{}).WithContext(c.ctx)
+	if !c.prefix {
+		pair, _, err := c.client.KV().Get(c.key, opts)
+		if err != nil || pair == nil {
+			return nil, err
+		}
+		return pair.Value, nil
+	}
+	pairs, _, err := c.client.KV().List(c.key, opts)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].Key < pairs[j].Key
+	})
+	cfg := make(map[string]any)
+	for _, pair := range pairs {
+		// 目录节点
+		if len(pair.Value) == 0 {
+			continue
+		}
+		codec := source.Codec(pair.Key)
+		if codec == nil {
+			codec = encoding.GetCodec(json.Name)
+		}
+		mp := make(map[string]any)
+		err = codec.Unmarshal(pair.Value, &mp)
+		if err != nil {
+			return nil, err
+		}
+		source.Merge(cfg, mp)
+	}
+	return encoding.GetCodec(json.Name).Marshal(cfg)
+}
+
+func (c *Consul) Watch() <-chan struct{} {
+	return c.notify
+}
+
+func (c *Consul) Close() error {
+	c.once.Do(c.cancel)
+	c.wg.Wait()
+	return nil
+}
+
+func (c *Consul) Format() string {
+	if c.prefix {
+		return json.Name
+	}
+	return c.format
+}
+
+func (c *Consul) Name() string {
+	return "consul:" + c.key
+}
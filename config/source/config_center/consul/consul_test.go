@@ -0,0 +1,115 @@
+package consul
+
+import (
+	"encoding/json"
+	"github.com/go-slark/slark/encoding"
+	"github.com/hashicorp/consul/api"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// agent 模拟consul kv接口, 支持blocking query
+type agent struct {
+	l      sync.Mutex
+	index  uint64
+	kv     map[string]string
+	change chan struct{}
+}
+
+func (a *agent) put(key, value string) {
+	a.l.Lock()
+	a.kv[key] = value
+	a.index++
+	close(a.change)
+	a.change = make(chan struct{})
+	a.l.Unlock()
+}
+
+func (a *agent) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	wait, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+	a.l.Lock()
+	if wait > 0 && wait == a.index {
+		change := a.change
+		a.l.Unlock()
+		select {
+		case <-change:
+		case <-r.Context().Done():
+			return
+		case <-time.After(time.Second):
+		}
+		a.l.Lock()
+	}
+	var pairs []*api.KVPair
+	_, recurse := r.URL.Query()["recurse"]
+	for k, v := range a.kv {
+		if k == key || (recurse && strings.HasPrefix(k, key)) {
+			pairs = append(pairs, &api.KVPair{Key: k, Value: []byte(v)})
+		}
+	}
+	index := a.index
+	a.l.Unlock()
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].Key < pairs[j].Key
+	})
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(index, 10))
+	if len(pairs) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(pairs)
+}
+
+func TestConsul(t *testing.T) {
+	a := &agent{
+		index: 1,
+		kv: map[string]string{
+			"app/00-base.yaml": "redis:\n  addr: a\n  db: 1\n",
+			"app/10-env.json":  `{"redis":{"addr":"b"}}`,
+		},
+		change: make(chan struct{}),
+	}
+	srv := httptest.NewServer(a)
+	defer srv.Close()
+	client, err := api.NewClient(&api.Config{Address: strings.TrimPrefix(srv.URL, "http://")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := New(client, "app/", Prefix(true), WaitTime(time.Second))
+	load := func() map[string]any {
+		data, e := c.Load()
+		if e != nil {
+			t.Fatal(e)
+		}
+		mp := make(map[string]any)
+		if e = encoding.GetCodec(c.Format()).Unmarshal(data, &mp); e != nil {
+			t.Fatal(e)
+		}
+		return mp["redis"].(map[string]any)
+	}
+	redis := load()
+	if redis["addr"] != "b" || redis["db"] != float64(1) {
+		t.Fatalf("unexpected merge result %v", redis)
+	}
+	// 等待首次blocking query建立
+	time.Sleep(100 * time.Millisecond)
+	a.put("app/10-env.json", `{"redis":{"addr":"c"}}`)
+	select {
+	case <-c.Watch():
+	case <-time.After(2 * time.Second):
+		t.Fatal("change not notified")
+	}
+	if load()["addr"] != "c" {
+		t.Fatal("reload not applied")
+	}
+	_ = c.Close()
+	if _, ok := <-c.Watch(); ok {
+		t.Fatal("watch channel not closed")
+	}
+}
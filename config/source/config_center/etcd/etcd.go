@@ -0,0 +1,137 @@
+package etcd
+
+import (
+	"context"
+	"github.com/go-slark/slark/config/source"
+	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/encoding/json"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"sync"
+)
+
+// Client *clientv3.Client满足该接口
+type Client interface {
+	clientv3.KV
+	clientv3.Watcher
+}
+
+type Option func(*Etcd)
+
+// Prefix 读取key前缀下的所有配置, 每个key按扩展名解码后按key顺序合并
+func Prefix(prefix bool) Option {
+	return func(e *Etcd) {
+		e.prefix = prefix
+	}
+}
+
+// Format 单个key的编码, 默认按key扩展名, 无扩展名时为json
+func Format(format string) Option {
+	return func(e *Etcd) {
+		e.format = format
+	}
+}
+
+type Etcd struct {
+	client Client
+	key    string
+	prefix bool
+	format string
+	ctx    context.Context
+	cancel context.CancelFunc
+	notify chan struct{}
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+func New(client Client, key string, opts ...Option) *Etcd {
+	e := &Etcd{
+		client: client,
+		key:    key,
+		format: source.Format(key, json.Name),
+		notify: make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	e.ctx, e.cancel = context.WithCancel(context.Background())
+	var wopts []clientv3.OpOption
+	if e.prefix {
+		wopts = append(wopts, clientv3.WithPrefix())
+	}
+	wc := client.Watch(e.ctx, key, wopts...)
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer close(e.notify)
+		for {
+			select {
+			case <-e.ctx.Done():
+				return
+			case rsp, ok := <-wc:
+				if !ok {
+					return
+				}
+				if rsp.Err() != nil || len(rsp.Events) == 0 {
+					continue
+				}
+				select {
+				case e.notify <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return e
+}
+
+func (e *Etcd) Load() ([]byte, error) {
+	var opts []clientv3.OpOption
+	if e.prefix {
+		opts = append(opts, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	}
+	rsp, err := e.client.Get(e.ctx, e.key, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if !e.prefix {
+		if len(rsp.Kvs) == 0 {
+			return nil, nil
+		}
+		return rsp.Kvs[0].Value, nil
+	}
+	cfg := make(map[string]any)
+	for _, kv := range rsp.Kvs {
+		mp := make(map[string]any)
+		codec := source.Codec(string(kv.Key))
+		if codec == nil {
+			codec = encoding.GetCodec(json.Name)
+		}
+		err = codec.Unmarshal(kv.Value, &mp)
+		if err != nil {
+			return nil, err
+		}
+		source.Merge(cfg, mp)
+	}
+	return encoding.GetCodec(json.Name).Marshal(cfg)
+}
+
+func (e *Etcd) Watch() <-chan struct{} {
+	return e.notify
+}
+
+func (e *Etcd) Close() error {
+	e.once.Do(e.cancel)
+	e.wg.Wait()
+	return nil
+}
+
+func (e *Etcd) Format() string {
+	if e.prefix {
+		return json.Name
+	}
+	return e.format
+}
+
+func (e *Etcd) Name() string {
+	return "etcd:" + e.key
+}
@@ -0,0 +1,102 @@
+package etcd
+
+import (
+	"context"
+	"github.com/go-slark/slark/encoding"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fake struct {
+	clientv3.KV
+	clientv3.Watcher
+	l  sync.Mutex
+	kv map[string]string
+	wc chan clientv3.WatchResponse
+}
+
+func (f *fake) Get(_ context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	f.l.Lock()
+	defer f.l.Unlock()
+	rsp := &clientv3.GetResponse{}
+	for k, v := range f.kv {
+		if k == key || (len(opts) > 0 && strings.HasPrefix(k, key)) {
+			rsp.Kvs = append(rsp.Kvs, &mvccpb.KeyValue{Key: []byte(k), Value: []byte(v)})
+		}
+	}
+	sort.Slice(rsp.Kvs, func(i, j int) bool {
+		return string(rsp.Kvs[i].Key) < string(rsp.Kvs[j].Key)
+	})
+	return rsp, nil
+}
+
+func (f *fake) Watch(_ context.Context, _ string, _ ...clientv3.OpOption) clientv3.WatchChan {
+	return f.wc
+}
+
+func (f *fake) put(key, value string) {
+	f.l.Lock()
+	f.kv[key] = value
+	f.l.Unlock()
+	f.wc <- clientv3.WatchResponse{Events: []*clientv3.Event{{Type: clientv3.EventTypePut}}}
+}
+
+func TestPrefix(t *testing.T) {
+	f := &fake{
+		kv: map[string]string{
+			"/app/00-base.yaml": "redis:\n  addr: a\n  db: 1\n",
+			"/app/10-env.json":  `{"redis":{"addr":"b"}}`,
+		},
+		wc: make(chan clientv3.WatchResponse),
+	}
+	e := New(f, "/app/", Prefix(true))
+	defer e.Close()
+	load := func() map[string]any {
+		data, err := e.Load()
+		if err != nil {
+			t.Fatal(err)
+		}
+		mp := make(map[string]any)
+		if err = encoding.GetCodec(e.Format()).Unmarshal(data, &mp); err != nil {
+			t.Fatal(err)
+		}
+		return mp["redis"].(map[string]any)
+	}
+	redis := load()
+	if redis["addr"] != "b" || redis["db"] != float64(1) {
+		t.Fatalf("unexpected merge result %v", redis)
+	}
+	f.put("/app/10-env.json", `{"redis":{"addr":"c"}}`)
+	select {
+	case <-e.Watch():
+	case <-time.After(time.Second):
+		t.Fatal("change not notified")
+	}
+	if load()["addr"] != "c" {
+		t.Fatal("reload not applied")
+	}
+}
+
+func TestKey(t *testing.T) {
+	f := &fake{
+		kv: map[string]string{"/app.yaml": "name: a\n"},
+		wc: make(chan clientv3.WatchResponse),
+	}
+	e := New(f, "/app.yaml")
+	data, err := e.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Format() != "yaml" || string(data) != "name: a\n" {
+		t.Fatalf("unexpected %s %s", e.Format(), data)
+	}
+	_ = e.Close()
+	if _, ok := <-e.Watch(); ok {
+		t.Fatal("watch channel not closed")
+	}
+}
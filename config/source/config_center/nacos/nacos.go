@@ -0,0 +1,104 @@
+package nacos
+
+import (
+	"github.com/go-slark/slark/config/source"
+	"github.com/go-slark/slark/encoding/json"
+	"github.com/nacos-group/nacos-sdk-go/v2/clients/config_client"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+	"sync"
+)
+
+type Option func(*Nacos)
+
+func Group(group string) Option {
+	return func(n *Nacos) {
+		n.group = group
+	}
+}
+
+// Format 配置编码, 默认按dataId扩展名, 无扩展名时为json
+func Format(format string) Option {
+	return func(n *Nacos) {
+		n.format = format
+	}
+}
+
+type Nacos struct {
+	client config_client.IConfigClient
+	dataID string
+	group  string
+	format string
+	notify chan struct{}
+	l      sync.Mutex
+	closed bool
+}
+
+func New(client config_client.IConfigClient, dataID string, opts ...Option) (*Nacos, error) {
+	n := &Nacos{
+		client: client,
+		dataID: dataID,
+		group:  "DEFAULT_GROUP",
+		format: source.Format(dataID, json.Name),
+		notify: make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+	err := client.ListenConfig(vo.ConfigParam{
+		DataId: n.dataID,
+		Group:  n.group,
+		OnChange: func(_, _, _, _ string) {
+			// 非阻塞, 未消费的通知合并为一次
+			n.l.Lock()
+			defer n.l.Unlock()
+			if n.closed {
+				return
+			}
+			select {
+			case n.notify <- struct{}{}:
+			default:
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (n *Nacos) Load() ([]byte, error) {
+	content, err := n.client.GetConfig(vo.ConfigParam{
+		DataId: n.dataID,
+		Group:  n.group,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(content), nil
+}
+
+func (n *Nacos) Watch() <-chan struct{} {
+	return n.notify
+}
+
+func (n *Nacos) Close() error {
+	err := n.client.CancelListenConfig(vo.ConfigParam{
+		DataId: n.dataID,
+		Group:  n.group,
+	})
+	n.l.Lock()
+	defer n.l.Unlock()
+	if !n.closed {
+		n.closed = true
+		close(n.notify)
+	}
+	return err
+}
+
+func (n *Nacos) Format() string {
+	return n.format
+}
+
+func (n *Nacos) Name() string {
+	return "nacos:" + n.group + "/" + n.dataID
+}
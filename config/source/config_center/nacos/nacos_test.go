@@ -0,0 +1,60 @@
+package nacos
+
+import (
+	"github.com/nacos-group/nacos-sdk-go/v2/clients/config_client"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+	"testing"
+)
+
+type fake struct {
+	config_client.IConfigClient
+	content  string
+	onChange func(namespace, group, dataId, data string)
+	canceled bool
+}
+
+func (f *fake) GetConfig(_ vo.ConfigParam) (string, error) {
+	return f.content, nil
+}
+
+func (f *fake) ListenConfig(param vo.ConfigParam) error {
+	f.onChange = param.OnChange
+	return nil
+}
+
+func (f *fake) CancelListenConfig(_ vo.ConfigParam) error {
+	f.canceled = true
+	return nil
+}
+
+func (f *fake) update(content string) {
+	f.content = content
+	f.onChange("", "DEFAULT_GROUP", "app.yaml", content)
+}
+
+func TestNacos(t *testing.T) {
+	f := &fake{content: "name: a\n"}
+	n, err := New(f, "app.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Format() != "yaml" {
+		t.Fatalf("unexpected format %s", n.Format())
+	}
+	// 通知未消费时不阻塞
+	f.update("name: b\n")
+	f.update("name: c\n")
+	<-n.Watch()
+	data, err := n.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "name: c\n" {
+		t.Fatalf("unexpected content %s", data)
+	}
+	_ = n.Close()
+	f.update("name: d\n")
+	if _, ok := <-n.Watch(); ok || !f.canceled {
+		t.Fatal("listener not closed")
+	}
+}
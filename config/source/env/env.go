@@ -9,9 +9,10 @@ import (
 )
 
 type Env struct {
-	prefix []string
-	ctx    context.Context
-	cancel context.CancelFunc
+	prefix    []string
+	separator string
+	ctx       context.Context
+	cancel    context.CancelFunc
 }
 
 type Option func(*Env)
@@ -22,6 +23,13 @@ func Prefix(prefix ...string) Option {
 	}
 }
 
+// Separator key按分隔符展开为多级, 如 slark_redis__addr -> redis.addr, 用于覆盖其他配置源的多级配置
+func Separator(sep string) Option {
+	return func(e *Env) {
+		e.separator = sep
+	}
+}
+
 func New(opts ...Option) *Env {
 	ctx, cancel := context.WithCancel(context.Background())
 	e := &Env{
@@ -49,13 +57,34 @@ func (e *Env) Load() ([]byte, error) {
 		if match && len(prefix) != len(key) {
 			key = strings.TrimPrefix(strings.TrimPrefix(key, prefix), "_")
 			if len(key) > 0 {
-				mp[key] = value
+				e.set(mp, key, value)
 			}
 		}
 	}
 	return encoding.GetCodec(json.Name).Marshal(mp)
 }
 
+func (e *Env) set(mp map[string]any, key, value string) {
+	if len(e.separator) == 0 {
+		mp[key] = value
+		return
+	}
+	paths := strings.Split(key, e.separator)
+	for _, path := range paths[:len(paths)-1] {
+		sub, ok := mp[path].(map[string]any)
+		if !ok {
+			sub = make(map[string]any)
+			mp[path] = sub
+		}
+		mp = sub
+	}
+	mp[paths[len(paths)-1]] = value
+}
+
+func (e *Env) Name() string {
+	return "env"
+}
+
 func (e *Env) match(str string) (string, bool) {
 	for _, prefix := range e.prefix {
 		if strings.HasPrefix(str, prefix) {
@@ -2,21 +2,33 @@ package file
 
 import (
 	"context"
+	"fmt"
 	"github.com/fsnotify/fsnotify"
-	"github.com/go-slark/slark/encoding/toml"
+	"github.com/go-slark/slark/config/source"
+	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/encoding/json"
 	"github.com/go-slark/slark/logger"
 	"github.com/go-slark/slark/pkg/routine"
-	"log"
 	"os"
 	"path/filepath"
-	"runtime"
+	"sort"
 	"strings"
+	"sync"
 )
 
+// k8s configmap挂载目录中指向当前版本的软链接
+const k8sData = "..data"
+
+// File 文件配置源, 按扩展名选择编码; path为目录时按文件名顺序合并目录下所有配置文件(conf.d)
 type File struct {
 	path   string
 	dir    string
+	isDir  bool
+	real   string
 	notify chan struct{}
+	done   chan struct{}
+	once   sync.Once
+	wg     sync.WaitGroup
 }
 
 func NewFile(path string) *File {
@@ -26,56 +38,127 @@ func NewFile(path string) *File {
 	}
 	f := &File{
 		path:   path,
-		dir:    dir(path),
 		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	info, err := os.Stat(path)
+	f.isDir = err == nil && info.IsDir()
+	if f.isDir {
+		f.dir = path
+	} else {
+		f.dir = filepath.Dir(path)
+	}
+	f.real, _ = filepath.EvalSymlinks(path)
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		logger.Log(context.TODO(), logger.ErrorLevel, map[string]interface{}{"error": err, "path": path}, "file watch error")
+		close(f.notify)
+		return f
+	}
+	err = w.Add(f.dir)
+	if err != nil {
+		_ = w.Close()
+		logger.Log(context.TODO(), logger.ErrorLevel, map[string]interface{}{"error": err, "path": path}, "file watch error")
+		close(f.notify)
+		return f
 	}
+	f.wg.Add(1)
 	routine.GoSafe(context.TODO(), func() {
-		f.watch()
+		defer f.wg.Done()
+		f.watch(w)
 	})
 	return f
 }
 
-func (f *File) watch() {
-	w, err := fsnotify.NewWatcher()
-	if err != nil {
-		logger.Log(context.TODO(), logger.FatalLevel, map[string]interface{}{"error": err})
-	}
-	defer w.Close()
-
-	routine.GoSafe(context.TODO(), func() {
-		for {
+func (f *File) watch(w *fsnotify.Watcher) {
+	defer func() {
+		_ = w.Close()
+		close(f.notify)
+	}()
+	for {
+		select {
+		case <-f.done:
+			return
+		case event, ok := <-w.Events:
+			if !ok {
+				return
+			}
+			if !f.match(event) {
+				continue
+			}
+			logger.Log(context.TODO(), logger.InfoLevel, map[string]interface{}{"file": event.Name, "op": event.Op.String()}, "file modify")
 			select {
-			case event := <-w.Events:
-				logger.Log(context.TODO(), logger.DebugLevel, map[string]interface{}{
-					"event": filepath.Clean(event.Name),
-					"path":  filepath.Clean(f.path),
-				})
-				// we only care about the config file with the following cases:
-				// 1 - if the config file was modified or created
-				// 2 - if the real path to the config file changed
-				const writeOrCreateMask = fsnotify.Write | fsnotify.Create
-				if event.Op&writeOrCreateMask != 0 && filepath.Clean(event.Name) == filepath.Clean(f.path) {
-					logger.Log(context.TODO(), logger.InfoLevel, map[string]interface{}{"file": event.Name}, "file modify")
-					select {
-					case f.notify <- struct{}{}:
-					default:
-					}
-				}
-			case e := <-w.Errors:
-				logger.Log(context.TODO(), logger.ErrorLevel, map[string]interface{}{"error": e}, "file watch error")
+			case f.notify <- struct{}{}:
+			default:
 			}
+		case e, ok := <-w.Errors:
+			if !ok {
+				return
+			}
+			logger.Log(context.TODO(), logger.ErrorLevel, map[string]interface{}{"error": e}, "file watch error")
 		}
-	})
+	}
+}
 
-	err = w.Add(f.dir)
-	if err != nil {
-		log.Fatal(err)
+func (f *File) match(event fsnotify.Event) bool {
+	if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) == 0 {
+		return false
+	}
+	name := filepath.Clean(event.Name)
+	// configmap更新: 替换..data软链接, 文件本身不产生事件
+	if filepath.Base(name) == k8sData {
+		return true
+	}
+	if f.isDir {
+		return source.Codec(name) != nil
 	}
-	<-make(chan struct{})
+	if name == f.path {
+		return true
+	}
+	// 软链接指向的真实文件发生变化
+	real, err := filepath.EvalSymlinks(f.path)
+	if err == nil && real != f.real {
+		f.real = real
+		return true
+	}
+	return false
 }
 
 func (f *File) Load() ([]byte, error) {
-	return os.ReadFile(f.path)
+	if !f.isDir {
+		return os.ReadFile(f.path)
+	}
+	entries, err := os.ReadDir(f.path)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		// 跳过子目录及隐藏文件(包括configmap的..data等)
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if source.Codec(entry.Name()) == nil {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	cfg := make(map[string]any)
+	for _, name := range names {
+		p := filepath.Join(f.path, name)
+		data, e := os.ReadFile(p)
+		if e != nil {
+			return nil, e
+		}
+		mp := make(map[string]any)
+		e = source.Codec(name).Unmarshal(data, &mp)
+		if e != nil {
+			return nil, fmt.Errorf("config file %s: %w", p, e)
+		}
+		source.Merge(cfg, mp)
+	}
+	return encoding.GetCodec(json.Name).Marshal(cfg)
 }
 
 func (f *File) Watch() <-chan struct{} {
@@ -83,45 +166,21 @@ func (f *File) Watch() <-chan struct{} {
 }
 
 func (f *File) Close() error {
-	close(f.notify)
+	f.once.Do(func() {
+		close(f.done)
+	})
+	f.wg.Wait()
 	return nil
 }
 
+// Format 目录合并后统一为json, 单文件按扩展名
 func (f *File) Format() string {
-	return toml.Name
-}
-
-func isDir(path string) (bool, error) {
-	f, err := os.Stat(path)
-	if err != nil {
-		return false, err
-	}
-	switch mode := f.Mode(); {
-	case mode.IsDir():
-		return true, nil
-	case mode.IsRegular():
-		return false, nil
+	if f.isDir {
+		return json.Name
 	}
-	return false, nil
+	return source.Format(f.path, json.Name)
 }
 
-func handleDir(dir string) string {
-	if runtime.GOOS == "windows" {
-		dir = strings.Replace(dir, "\\", "/", -1)
-	}
-
-	runes := []rune(dir)
-	l := strings.LastIndex(dir, "/")
-	if l > len(runes) {
-		l = len(runes)
-	}
-	return string(runes[0:l])
-}
-
-func dir(path string) string {
-	ok, err := isDir(path)
-	if ok || err != nil {
-		return path
-	}
-	return handleDir(path)
+func (f *File) Name() string {
+	return "file:" + f.path
 }
@@ -0,0 +1,108 @@
+package file
+
+import (
+	"github.com/go-slark/slark/encoding"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func decode(t *testing.T, f *File) map[string]any {
+	t.Helper()
+	data, err := f.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mp := make(map[string]any)
+	err = encoding.GetCodec(f.Format()).Unmarshal(data, &mp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mp
+}
+
+func wait(t *testing.T, f *File) {
+	t.Helper()
+	select {
+	case <-f.Watch():
+	case <-time.After(2 * time.Second):
+		t.Fatal("change not notified")
+	}
+}
+
+func TestFileFormat(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.yml")
+	if err := os.WriteFile(path, []byte("redis:\n  addr: a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f := NewFile(path)
+	if f.Format() != "yaml" {
+		t.Fatalf("unexpected format %s", f.Format())
+	}
+	if err := os.WriteFile(path, []byte("redis:\n  addr: b\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	wait(t, f)
+	if decode(t, f)["redis"].(map[string]any)["addr"] != "b" {
+		t.Fatal("reload not applied")
+	}
+	_ = f.Close()
+	if _, ok := <-f.Watch(); ok {
+		t.Fatal("watch channel not closed")
+	}
+}
+
+func TestDirectory(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"00-base.json":  `{"redis":{"addr":"a","db":1},"name":"base"}`,
+		"10-redis.yaml": "redis:\n  addr: b\n",
+		"20-mysql.xml":  "<config><mysql><addr>c</addr></mysql></config>",
+		"README.md":     "ignored",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f := NewFile(dir)
+	defer f.Close()
+	mp := decode(t, f)
+	redis := mp["redis"].(map[string]any)
+	if redis["addr"] != "b" || redis["db"] != float64(1) || mp["mysql"].(map[string]any)["addr"] != "c" {
+		t.Fatalf("unexpected merge result %+v", mp)
+	}
+}
+
+func TestConfigMapSymlink(t *testing.T) {
+	dir := t.TempDir()
+	write := func(version, content string) {
+		vd := filepath.Join(dir, version)
+		if err := os.Mkdir(vd, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(vd, "app.json"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		tmp := filepath.Join(dir, "..data_tmp")
+		if err := os.Symlink(version, tmp); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Rename(tmp, filepath.Join(dir, k8sData)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("..v1", `{"v":1}`)
+	if err := os.Symlink(filepath.Join(k8sData, "app.json"), filepath.Join(dir, "app.json")); err != nil {
+		t.Fatal(err)
+	}
+	f := NewFile(filepath.Join(dir, "app.json"))
+	defer f.Close()
+	write("..v2", `{"v":2}`)
+	wait(t, f)
+	if decode(t, f)["v"] != float64(2) {
+		t.Fatal("configmap swap not loaded")
+	}
+}
@@ -0,0 +1,67 @@
+package source
+
+import (
+	"fmt"
+	"github.com/go-slark/slark/encoding"
+	_ "github.com/go-slark/slark/encoding/json"
+	_ "github.com/go-slark/slark/encoding/properties"
+	_ "github.com/go-slark/slark/encoding/toml"
+	_ "github.com/go-slark/slark/encoding/xml"
+	_ "github.com/go-slark/slark/encoding/yaml"
+	"path/filepath"
+	"strings"
+)
+
+// 配置源公共方法: 按扩展名选择编码, 多份配置合并
+
+// Codec 按扩展名查找编码, 未注册时返回nil
+func Codec(name string) encoding.Codec {
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(name), "."))
+	if ext == "yml" {
+		ext = "yaml"
+	}
+	if len(ext) == 0 {
+		return nil
+	}
+	return encoding.GetCodec(ext)
+}
+
+// Format 按扩展名返回编码名称, 无法识别时返回def
+func Format(name, def string) string {
+	c := Codec(name)
+	if c == nil {
+		return def
+	}
+	return c.Name()
+}
+
+// Merge 后合并的覆盖先合并的, 均为map时逐级合并
+func Merge(dest, src map[string]any) {
+	for k, sv := range src {
+		sm, ok := toMap(sv)
+		if !ok {
+			dest[k] = sv
+			continue
+		}
+		dm, ok := toMap(dest[k])
+		if !ok {
+			dm = make(map[string]any)
+		}
+		Merge(dm, sm)
+		dest[k] = dm
+	}
+}
+
+func toMap(v any) (map[string]any, bool) {
+	switch vv := v.(type) {
+	case map[string]any:
+		return vv, true
+	case map[any]any:
+		m := make(map[string]any, len(vv))
+		for k, sv := range vv {
+			m[fmt.Sprintf("%v", k)] = sv
+		}
+		return m, true
+	}
+	return nil, false
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"context"
+	"github.com/go-slark/slark/logger"
+	"sync/atomic"
+)
+
+// Value 配置变化时整体替换, 读取无锁
+type Value[T any] struct {
+	p atomic.Pointer[T]
+}
+
+func (v *Value[T]) Load() *T {
+	return v.p.Load()
+}
+
+// Scan 解码key对应配置为T, key为空时解码全部配置; 配置变化时重新解码并替换, 解码失败时保留旧值
+func Scan[T any](c *Config, key string) (*Value[T], error) {
+	v := &Value[T]{}
+	decode := func() error {
+		t := new(T)
+		var err error
+		if len(key) == 0 {
+			err = c.Unmarshal(t)
+		} else {
+			err = c.Unmarshal(t, key)
+		}
+		if err != nil {
+			return err
+		}
+		v.p.Store(t)
+		return nil
+	}
+	err := decode()
+	if err != nil {
+		return nil, err
+	}
+	c.Watch(key, func(_, _ any) {
+		e := decode()
+		if e != nil {
+			logger.Log(context.TODO(), logger.WarnLevel, map[string]interface{}{"error": e, "key": key}, "config scan error")
+		}
+	})
+	return v, nil
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"errors"
+	"github.com/go-slark/slark/encoding/json"
+	"sync"
+	"testing"
+	"time"
+)
+
+type source struct {
+	l      sync.Mutex
+	data   string
+	notify chan struct{}
+}
+
+func (s *source) Load() ([]byte, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return []byte(s.data), nil
+}
+
+func (s *source) Watch() <-chan struct{} {
+	return s.notify
+}
+
+func (s *source) Close() error {
+	close(s.notify)
+	return nil
+}
+
+func (s *source) Format() string {
+	return json.Name
+}
+
+func (s *source) update(data string) {
+	s.l.Lock()
+	s.data = data
+	s.l.Unlock()
+	s.notify <- struct{}{}
+}
+
+type redisConf struct {
+	Addr    string        `json:"addr"`
+	Timeout time.Duration `json:"timeout"`
+}
+
+func TestWatch(t *testing.T) {
+	src := &source{
+		data:   `{"redis":{"addr":"127.0.0.1:6379","timeout":"1s","nodes":"a, b"},"mysql":{"addr":"db:3306"},"debug":true,"port":8080}`,
+		notify: make(chan struct{}),
+	}
+	c := New(WithSource(src), Validator(func(m map[string]any) error {
+		if lookup(m, "mysql.addr", ".") == "" {
+			return errors.New("mysql addr empty")
+		}
+		return nil
+	}))
+	type change struct {
+		key      string
+		old, new any
+	}
+	changes := make(chan change, 8)
+	c.Watch("redis", func(old, new any) {
+		changes <- change{"redis", old, new}
+	})
+	c.Watch("mysql.addr", func(old, new any) {
+		changes <- change{"mysql.addr", old, new}
+	})
+	err := c.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 首次加载
+	<-changes
+	<-changes
+
+	if c.GetInt("port") != 8080 || !c.GetBool("debug") || c.GetDuration("redis.timeout") != time.Second {
+		t.Fatalf("typed getters: %v %v %v", c.GetInt("port"), c.GetBool("debug"), c.GetDuration("redis.timeout"))
+	}
+	if ss := c.GetStringSlice("redis.nodes"); len(ss) != 2 || ss[1] != "b" {
+		t.Fatalf("string slice: %v", ss)
+	}
+	redis, err := Scan[redisConf](c, "redis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redis.Load().Addr != "127.0.0.1:6379" || redis.Load().Timeout != time.Second {
+		t.Fatalf("scan: %+v", redis.Load())
+	}
+
+	src.update(`{"redis":{"addr":"127.0.0.1:6380","timeout":"1s","nodes":"a, b"},"mysql":{"addr":"db:3306"},"debug":true,"port":8080}`)
+	select {
+	case ch := <-changes:
+		if ch.key != "redis" {
+			t.Fatalf("unexpected watcher fired: %s", ch.key)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("watcher not fired")
+	}
+	select {
+	case ch := <-changes:
+		t.Fatalf("unaffected watcher fired: %+v", ch)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if redis.Load().Addr != "127.0.0.1:6380" || c.GetString("redis.addr") != "127.0.0.1:6380" {
+		t.Fatalf("reload not applied: %+v", redis.Load())
+	}
+
+	// 校验失败保留上一次配置
+	src.update(`{"mysql":{"addr":""},"redis":{"addr":"bad"}}`)
+	time.Sleep(50 * time.Millisecond)
+	if c.GetString("mysql.addr") != "db:3306" || c.GetString("redis.addr") != "127.0.0.1:6380" {
+		t.Fatalf("bad reload applied: %v %v", c.Get("mysql.addr"), c.Get("redis.addr"))
+	}
+}
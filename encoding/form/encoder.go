@@ -0,0 +1,163 @@
+package form
+
+import (
+	"encoding/base64"
+	"fmt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Encode 将已赋值字段编码为url.Values, 与Unmarshal对应: 字段名为proto name, 嵌套字段为a.b, map为a.key
+// 无法表示的repeated message及map message字段忽略
+func Encode(msg proto.Message) (url.Values, error) {
+	values := url.Values{}
+	if msg == nil {
+		return values, nil
+	}
+	return values, encodeMessage(values, "", msg.ProtoReflect())
+}
+
+func encodeMessage(values url.Values, prefix string, m protoreflect.Message) error {
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		key := prefix + string(fd.Name())
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				s, ok, e := encodeValue(fd, list.Get(i))
+				if e != nil {
+					err = e
+					return false
+				}
+				if ok {
+					values.Add(key, s)
+				}
+			}
+		case fd.IsMap():
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				s, ok, e := encodeValue(fd.MapValue(), mv)
+				if e != nil {
+					err = e
+					return false
+				}
+				if ok {
+					values.Set(key+"."+k.String(), s)
+				}
+				return true
+			})
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			s, ok, e := encodeValue(fd, v)
+			if e != nil {
+				err = e
+				return false
+			}
+			if ok {
+				values.Set(key, s)
+				return true
+			}
+			err = encodeMessage(values, key+".", v.Message())
+		default:
+			s, _, e := encodeValue(fd, v)
+			if e != nil {
+				err = e
+				return false
+			}
+			values.Set(key, s)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// encodeValue 标量及well-known type编码为单个值, 其余message返回false
+func encodeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (string, bool, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool()), true, nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name()), true, nil
+		}
+		return strconv.FormatInt(int64(v.Enum()), 10), true, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10), true, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10), true, nil
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32), true, nil
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), true, nil
+	case protoreflect.StringKind:
+		return v.String(), true, nil
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes()), true, nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return encodeWKT(v.Message())
+	}
+	return "", false, fmt.Errorf("unknown field kind: %v", fd.Kind())
+}
+
+func encodeWKT(m protoreflect.Message) (string, bool, error) {
+	switch m.Descriptor().FullName() {
+	case "google.protobuf.Timestamp":
+		return m.Interface().(*timestamppb.Timestamp).AsTime().Format(time.RFC3339Nano), true, nil
+	case "google.protobuf.Duration":
+		return m.Interface().(*durationpb.Duration).AsDuration().String(), true, nil
+	case "google.protobuf.FieldMask":
+		return strings.Join(m.Interface().(*fieldmaskpb.FieldMask).GetPaths(), ","), true, nil
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue", "google.protobuf.Int64Value",
+		"google.protobuf.Int32Value", "google.protobuf.UInt64Value", "google.protobuf.UInt32Value",
+		"google.protobuf.BoolValue", "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		fd := m.Descriptor().Fields().ByName("value")
+		return encodeValue(fd, m.Get(fd))
+	case "google.protobuf.Value":
+		if s, ok := m.Interface().(*structpb.Value).GetKind().(*structpb.Value_StringValue); ok {
+			return s.StringValue, true, nil
+		}
+		b, err := protojson.Marshal(m.Interface())
+		return string(b), true, err
+	case "google.protobuf.Struct":
+		b, err := protojson.Marshal(m.Interface())
+		return string(b), true, err
+	}
+	return "", false, nil
+}
+
+// Field 按a.b路径读取字段值, 包含零值, 用于展开路径参数
+func Field(msg proto.Message, path string) (string, error) {
+	m := msg.ProtoReflect()
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := getDescriptorByField(m.Descriptor().Fields(), name)
+		if fd == nil {
+			return "", fmt.Errorf("field %s not found in %s", path, m.Descriptor().FullName())
+		}
+		if i < len(names)-1 {
+			if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+				return "", fmt.Errorf("path %s is not message", name)
+			}
+			m = m.Get(fd).Message()
+			continue
+		}
+		if fd.IsList() || fd.IsMap() {
+			return "", fmt.Errorf("field %s is not singular", path)
+		}
+		s, ok, err := encodeValue(fd, m.Get(fd))
+		if err == nil && !ok {
+			err = fmt.Errorf("field %s is not scalar", path)
+		}
+		return s, err
+	}
+	return "", nil
+}
@@ -24,11 +24,17 @@ type codec struct {
 }
 
 func (*codec) Marshal(v interface{}) ([]byte, error) {
-	values, ok := v.(url.Values)
-	if !ok {
-		return nil, errors.BadRequest(errors.ParamError, errors.ParamError)
+	switch m := v.(type) {
+	case url.Values:
+		return []byte(m.Encode()), nil
+	case proto.Message:
+		values, err := Encode(m)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(values.Encode()), nil
 	}
-	return []byte(values.Encode()), nil
+	return nil, errors.BadRequest(errors.ParamError, errors.ParamError)
 }
 
 func (c *codec) Unmarshal(data []byte, v interface{}) error {
@@ -52,8 +52,10 @@ func (codec) Unmarshal(data []byte, v interface{}) error {
 			}
 			value = value.Elem()
 		}
-		if m, ok := reflect.Indirect(value).Interface().(proto.Message); ok {
-			return UnmarshalOptions.Unmarshal(data, m)
+		if value.CanAddr() {
+			if m, ok := value.Addr().Interface().(proto.Message); ok {
+				return UnmarshalOptions.Unmarshal(data, m)
+			}
 		}
 		return json.Unmarshal(data, m)
 	}
@@ -1,8 +1,11 @@
 package xml
 
 import (
+	"bytes"
 	"encoding/xml"
 	"github.com/go-slark/slark/encoding"
+	"io"
+	"strings"
 )
 
 type codec struct{}
@@ -18,9 +21,79 @@ func (c codec) Marshal(v any) ([]byte, error) {
 }
 
 func (c codec) Unmarshal(data []byte, v any) error {
+	if m, ok := v.(*map[string]any); ok {
+		return unmarshalMap(data, m)
+	}
 	return xml.Unmarshal(data, v)
 }
 
+// unmarshalMap 根元素的子元素作为key, 叶子元素取文本, 同名元素合并为列表; 用于配置解析
+func unmarshalMap(data []byte, m *map[string]any) error {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			v, err := element(d, start)
+			if err != nil {
+				return err
+			}
+			if *m == nil {
+				*m = make(map[string]any)
+			}
+			if mp, ok := v.(map[string]any); ok {
+				for k, vv := range mp {
+					(*m)[k] = vv
+				}
+			}
+			return nil
+		}
+	}
+}
+
+func element(d *xml.Decoder, start xml.StartElement) (any, error) {
+	children := make(map[string]any)
+	var text strings.Builder
+	for _, attr := range start.Attr {
+		children[attr.Name.Local] = attr.Value
+	}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			v, err := element(d, t)
+			if err != nil {
+				return nil, err
+			}
+			key := t.Name.Local
+			if old, ok := children[key]; ok {
+				list, ok := old.([]any)
+				if !ok {
+					list = []any{old}
+				}
+				children[key] = append(list, v)
+			} else {
+				children[key] = v
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			if len(children) == 0 {
+				return strings.TrimSpace(text.String()), nil
+			}
+			return children, nil
+		}
+	}
+}
+
 func init() {
 	encoding.RegisterCodec(codec{})
 }
@@ -0,0 +1,148 @@
+package errors
+
+import (
+	"encoding/json"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+// 错误详情, 兼容google.rpc error details, grpc通过status details传递, http编码为带@type的json
+
+func (e *Error) WithDetails(details ...proto.Message) *Error {
+	err := clone(e)
+	err.details = append(err.details, details...)
+	return err
+}
+
+func (e *Error) Details() []proto.Message {
+	if e == nil {
+		return nil
+	}
+	return e.details
+}
+
+// WithFieldViolation 参数校验失败的字段, 多个字段合并到同一个BadRequest
+func (e *Error) WithFieldViolation(field, desc string) *Error {
+	err := clone(e)
+	violation := &errdetails.BadRequest_FieldViolation{Field: field, Description: desc}
+	for _, d := range err.details {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			br.FieldViolations = append(br.FieldViolations, violation)
+			return err
+		}
+	}
+	err.details = append(err.details, &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{violation}})
+	return err
+}
+
+// WithRetry 建议客户端重试的间隔
+func (e *Error) WithRetry(delay time.Duration) *Error {
+	return e.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+}
+
+func (e *Error) WithQuotaViolation(subject, desc string) *Error {
+	err := clone(e)
+	violation := &errdetails.QuotaFailure_Violation{Subject: subject, Description: desc}
+	for _, d := range err.details {
+		if qf, ok := d.(*errdetails.QuotaFailure); ok {
+			qf.Violations = append(qf.Violations, violation)
+			return err
+		}
+	}
+	err.details = append(err.details, &errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{violation}})
+	return err
+}
+
+func (e *Error) WithLocalized(locale, msg string) *Error {
+	return e.WithDetails(&errdetails.LocalizedMessage{Locale: locale, Message: msg})
+}
+
+func detail[T proto.Message](err error) (T, bool) {
+	var zero T
+	e := FromError(err)
+	if e == nil {
+		return zero, false
+	}
+	for _, d := range e.details {
+		if v, ok := d.(T); ok {
+			return v, true
+		}
+	}
+	return zero, false
+}
+
+func FieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	br, ok := detail[*errdetails.BadRequest](err)
+	if !ok {
+		return nil
+	}
+	return br.FieldViolations
+}
+
+func RetryDelay(err error) (time.Duration, bool) {
+	ri, ok := detail[*errdetails.RetryInfo](err)
+	if !ok || ri.RetryDelay == nil {
+		return 0, false
+	}
+	return ri.RetryDelay.AsDuration(), true
+}
+
+func QuotaViolations(err error) []*errdetails.QuotaFailure_Violation {
+	qf, ok := detail[*errdetails.QuotaFailure](err)
+	if !ok {
+		return nil
+	}
+	return qf.Violations
+}
+
+func Localized(err error) (*errdetails.LocalizedMessage, bool) {
+	return detail[*errdetails.LocalizedMessage](err)
+}
+
+// EncodeDetails 编码为{"@type": "type.googleapis.com/google.rpc.BadRequest", ...}, 可被任意codec序列化
+func EncodeDetails(details []proto.Message) ([]map[string]any, error) {
+	out := make([]map[string]any, 0, len(details))
+	for _, d := range details {
+		a, err := anypb.New(d)
+		if err != nil {
+			return nil, err
+		}
+		data, err := protojson.Marshal(a)
+		if err != nil {
+			return nil, err
+		}
+		mp := make(map[string]any)
+		err = json.Unmarshal(data, &mp)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, mp)
+	}
+	return out, nil
+}
+
+// DecodeDetails 未注册的@type跳过
+func DecodeDetails(raw []map[string]any) []proto.Message {
+	details := make([]proto.Message, 0, len(raw))
+	for _, mp := range raw {
+		data, err := json.Marshal(mp)
+		if err != nil {
+			continue
+		}
+		a := &anypb.Any{}
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, a)
+		if err != nil {
+			continue
+		}
+		d, err := a.UnmarshalNew()
+		if err != nil {
+			continue
+		}
+		details = append(details, d)
+	}
+	return details
+}
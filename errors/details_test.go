@@ -0,0 +1,101 @@
+package errors
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+var ErrQuota = ServerRateLimit("quota exceeded", "ERR_USER_QUOTA")
+
+func TestDetails(t *testing.T) {
+	err := BadRequest("invalid param", "ERR_USER_PARAM").
+		WithFieldViolation("name", "required").
+		WithFieldViolation("age", "must be positive").
+		WithRetry(3*time.Second).
+		WithQuotaViolation("uid:1", "daily limit").
+		WithLocalized("en", "invalid param")
+
+	// grpc
+	gs := err.GRPCStatus()
+	if gs.Code() != codes.InvalidArgument {
+		t.Fatalf("unexpected code %v", gs.Code())
+	}
+	e := FromError(status.ErrorProto(gs.Proto()))
+	if e.Reason != "ERR_USER_PARAM" || len(e.Details()) != 4 {
+		t.Fatalf("unexpected error %v %v", e, e.Details())
+	}
+	if fv := FieldViolations(e); len(fv) != 2 || fv[1].Field != "age" {
+		t.Fatalf("unexpected violations %v", fv)
+	}
+	if d, ok := RetryDelay(e); !ok || d != 3*time.Second {
+		t.Fatalf("unexpected retry %v", d)
+	}
+	if qv := QuotaViolations(Wrap(e, "wrap")); len(qv) != 1 || qv[0].Subject != "uid:1" {
+		t.Fatalf("unexpected quota %v", qv)
+	}
+
+	// http
+	raw, e2 := EncodeDetails(err.Details())
+	if e2 != nil {
+		t.Fatal(e2)
+	}
+	if raw[0]["@type"] != "type.googleapis.com/google.rpc.BadRequest" {
+		t.Fatalf("unexpected detail %v", raw[0])
+	}
+	if lm, ok := Localized(New(400, "", "").WithDetails(DecodeDetails(raw)...)); !ok || lm.Message != "invalid param" {
+		t.Fatalf("unexpected localized %v", lm)
+	}
+
+	// Wrap后的错误与原错误不共享details
+	w := Wrap(err, "wrap").(*Error)
+	w.Details()[0].(*errdetails.BadRequest).FieldViolations = nil
+	if len(FieldViolations(err)) != 2 {
+		t.Fatal("wrapped error shares details")
+	}
+
+	// 包级别错误不被修改
+	_ = ErrQuota.WithRetry(time.Second)
+	_ = ErrQuota.WithRetry(time.Second)
+	if len(ErrQuota.Details()) != 0 {
+		t.Fatal("sentinel error modified")
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	c := NewCatalog()
+	_ = c.Load("en", map[string]string{"ERR_USER_NOT_FOUND": "user {uid} not found"})
+	_ = c.Load("zh-CN", map[string]string{"ERR_USER_NOT_FOUND": "用户{uid}不存在"})
+	err := NotFound("user not found", "ERR_USER_NOT_FOUND").WithMetadata(map[string]string{"uid": "42"})
+
+	e := c.Localize(err, "zh-CN,zh;q=0.9,en;q=0.8")
+	if e.Message != "用户42不存在" {
+		t.Fatalf("unexpected message %s", e.Message)
+	}
+	if lm, ok := Localized(e); !ok || lm.Locale != "zh-CN" {
+		t.Fatalf("unexpected localized %v", lm)
+	}
+	if e = c.Localize(err, "en-US"); e.Message != "user 42 not found" {
+		t.Fatalf("unexpected message %s", e.Message)
+	}
+	if e = c.Localize(err, "fr"); e != err {
+		t.Fatalf("unexpected message %s", e.Message)
+	}
+	if err.Message != "user not found" {
+		t.Fatal("origin error modified")
+	}
+}
+
+func TestRender(t *testing.T) {
+	if s := Render("user {{.id}} not found", map[string]string{"id": "42"}); s != "user 42 not found" {
+		t.Fatalf("unexpected message %s", s)
+	}
+	if s := Render("user {{.id}} not found", nil); s != "user  not found" {
+		t.Fatalf("unexpected message %s", s)
+	}
+	if s := Render("user {{.id not found", nil); s != "user {{.id not found" {
+		t.Fatalf("unexpected message %s", s)
+	}
+}
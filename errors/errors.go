@@ -6,7 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
 	"io"
 	"runtime"
 	"strings"
@@ -18,8 +21,10 @@ type stack []uintptr
 
 type Error struct {
 	Status
-	stack stack
-	clone bool
+	stack   stack
+	details []proto.Message
+	// 非0时覆盖由http code转换的grpc code
+	grpcCode codes.Code
 	error
 }
 
@@ -93,14 +98,18 @@ func Wrap(err error, text string) error {
 		return nil
 	}
 
+	// 拷贝details及metadata, 与被包装的错误互不影响
+	c := clone(FromError(err))
 	return &Error{
-		error: err,
-		stack: callers(),
+		error:    err,
+		stack:    callers(),
+		details:  c.details,
+		grpcCode: c.grpcCode,
 		Status: Status{
-			Message:  Message(err),
+			Message:  c.Message,
 			Reason:   text,
-			Code:     int32(Code(err)),
-			Metadata: Metadata(err),
+			Code:     c.Code,
+			Metadata: c.Metadata,
 		},
 	}
 }
@@ -151,29 +160,51 @@ func (e *Error) WithReason(reason string) *Error {
 	return err
 }
 
+// WithGRPCCode 指定grpc code, 如409对应ALREADY_EXISTS而非默认的ABORTED
+func (e *Error) WithGRPCCode(code codes.Code) *Error {
+	err := clone(e)
+	err.grpcCode = code
+	return err
+}
+
+// GRPCCode 写入grpc status的code
+func (e *Error) GRPCCode() codes.Code {
+	if e.grpcCode != codes.OK {
+		return e.grpcCode
+	}
+	return HTTPToGRPCCode(int(e.Code))
+}
+
 // write error code to grpc status
 
 func (e *Error) GRPCStatus() *status.Status {
-	eInfo := &errdetails.ErrorInfo{
+	details := make([]protoadapt.MessageV1, 0, len(e.details)+1)
+	details = append(details, &errdetails.ErrorInfo{
 		Reason:   e.Reason,
 		Metadata: e.Metadata,
+	})
+	for _, d := range e.details {
+		details = append(details, protoadapt.MessageV1Of(d))
 	}
-	s, _ := status.New(HTTPToGRPCCode(int(e.Code)), e.Message).WithDetails(eInfo)
+	s, _ := status.New(e.GRPCCode(), e.Message).WithDetails(details...)
 	return s
 }
 
+// clone 总是复制, 包级别定义的错误被With*修改时不影响原错误
 func clone(err *Error) *Error {
-	if err.clone {
-		return err
-	}
-	err.clone = true
 	metadata := make(map[string]string, len(err.Metadata))
 	for k, v := range err.Metadata {
 		metadata[k] = v
 	}
+	details := make([]proto.Message, 0, len(err.details))
+	for _, d := range err.details {
+		details = append(details, proto.Clone(d))
+	}
 	return &Error{
-		error: err.error,
-		stack: err.stack,
+		error:    err.error,
+		stack:    err.stack,
+		details:  details,
+		grpcCode: err.grpcCode,
 		Status: Status{
 			Code:     err.Code,
 			Reason:   err.Reason,
@@ -195,12 +226,16 @@ func FromError(err error) *Error {
 		return New(UnknownCode, err.Error(), UnknownReason)
 	}
 	ret := New(GRPCToHTTPCode(gs.Code()), gs.Message(), UnknownReason)
+	if HTTPToGRPCCode(int(ret.Code)) != gs.Code() {
+		ret.grpcCode = gs.Code()
+	}
 	for _, detail := range gs.Details() {
 		switch d := detail.(type) {
 		case *errdetails.ErrorInfo:
 			ret.Reason = d.Reason
-			ret = ret.WithMetadata(d.Metadata)
-			return ret
+			ret.Metadata = d.Metadata
+		case proto.Message:
+			ret.details = append(ret.details, d)
 		}
 	}
 	return ret
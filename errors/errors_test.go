@@ -3,6 +3,8 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"testing"
 )
 
@@ -31,3 +33,18 @@ func TestWrapError(t *testing.T) {
 func TestReWrapError(t *testing.T) {
 	fmt.Printf("%+v\n", ReWrapError())
 }
+
+func TestGRPCCode(t *testing.T) {
+	err := New(409, "user exists", "USER_EXISTS").WithGRPCCode(codes.AlreadyExists)
+	if c := status.Code(Wrap(err, "wrap")); c != codes.AlreadyExists {
+		t.Fatalf("unexpected grpc code %v", c)
+	}
+	// 经grpc传递后保留http code及grpc code
+	e := FromError(status.ErrorProto(err.GRPCStatus().Proto()))
+	if e.Code != 409 || e.GRPCCode() != codes.AlreadyExists || e.Reason != "USER_EXISTS" {
+		t.Fatalf("unexpected error %v %v", e, e.GRPCCode())
+	}
+	if c := New(409, "", "").GRPCCode(); c != codes.Aborted {
+		t.Fatalf("unexpected default grpc code %v", c)
+	}
+}
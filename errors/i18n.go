@@ -0,0 +1,119 @@
+package errors
+
+import (
+	"golang.org/x/text/language"
+	"strings"
+	"sync"
+)
+
+// Catalog 按reason及语言本地化Message, 文案中的{key}使用Metadata中的值替换
+type Catalog struct {
+	l       sync.RWMutex
+	entries map[string]*entry
+}
+
+// entry 同一reason的多语言文案, matcher返回的下标对应tags
+type entry struct {
+	tags     []language.Tag
+	messages []string
+	matcher  language.Matcher
+}
+
+var catalog = NewCatalog()
+
+func GetCatalog() *Catalog {
+	return catalog
+}
+
+func NewCatalog() *Catalog {
+	return &Catalog{
+		entries: make(map[string]*entry),
+	}
+}
+
+// Add lang为BCP 47, 如zh-CN / en
+func (c *Catalog) Add(lang, reason, message string) error {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return err
+	}
+	c.l.Lock()
+	defer c.l.Unlock()
+	e, ok := c.entries[reason]
+	if !ok {
+		e = &entry{}
+		c.entries[reason] = e
+	}
+	for i, t := range e.tags {
+		if t == tag {
+			e.messages[i] = message
+			return nil
+		}
+	}
+	e.tags = append(e.tags, tag)
+	e.messages = append(e.messages, message)
+	e.matcher = language.NewMatcher(e.tags)
+	return nil
+}
+
+// Load 批量添加同一语言的文案, messages: reason -> message
+func (c *Catalog) Load(lang string, messages map[string]string) error {
+	for reason, msg := range messages {
+		err := c.Add(lang, reason, msg)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Lookup accept为Accept-Language格式, 如zh-CN,zh;q=0.9,en;q=0.8, 返回匹配的语言及文案
+func (c *Catalog) Lookup(reason, accept string) (string, string, bool) {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	e, ok := c.entries[reason]
+	if !ok {
+		return "", "", false
+	}
+	tags, _, err := language.ParseAcceptLanguage(accept)
+	if err != nil || len(tags) == 0 {
+		return "", "", false
+	}
+	_, index, confidence := e.matcher.Match(tags...)
+	if confidence == language.No {
+		return "", "", false
+	}
+	return e.tags[index].String(), e.messages[index], true
+}
+
+// Localize 返回本地化后的副本, 并附带LocalizedMessage详情; 未找到文案时返回原错误
+func (c *Catalog) Localize(e *Error, accept string) *Error {
+	if e == nil || len(accept) == 0 {
+		return e
+	}
+	locale, msg, ok := c.Lookup(e.Reason, accept)
+	if !ok {
+		return e
+	}
+	if len(e.Metadata) > 0 {
+		pairs := make([]string, 0, 2*len(e.Metadata))
+		for k, v := range e.Metadata {
+			pairs = append(pairs, "{"+k+"}", v)
+		}
+		msg = strings.NewReplacer(pairs...).Replace(msg)
+	}
+	return e.WithMessage(msg).WithLocalized(locale, msg)
+}
+
+// Localize 使用全局Catalog
+func Localize(err error, accept string) error {
+	if err == nil || len(accept) == 0 {
+		return err
+	}
+	e := FromError(err)
+	le := catalog.Localize(e, accept)
+	if le == e {
+		return err
+	}
+	return le
+}
@@ -0,0 +1,33 @@
+package errors
+
+import (
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// 已解析的错误信息模板, protoc-gen-errors生成的XxxWithArgs使用
+var templates sync.Map
+
+// Render 使用text/template渲染错误信息, 如: "user {{.id}} not found", 模板非法时返回原文
+func Render(text string, args map[string]string) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	var tmpl *template.Template
+	if v, ok := templates.Load(text); ok {
+		tmpl = v.(*template.Template)
+	} else {
+		t, err := template.New("error").Option("missingkey=zero").Parse(text)
+		if err != nil {
+			return text
+		}
+		v, _ = templates.LoadOrStore(text, t)
+		tmpl = v.(*template.Template)
+	}
+	b := &strings.Builder{}
+	if err := tmpl.Execute(b, args); err != nil {
+		return text
+	}
+	return b.String()
+}
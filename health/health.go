@@ -0,0 +1,259 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Status 与grpc.health.v1.HealthCheckResponse_ServingStatus取值一致
+type Status int32
+
+const (
+	Unknown Status = iota
+	Serving
+	NotServing
+)
+
+func (s Status) String() string {
+	switch s {
+	case Serving:
+		return "SERVING"
+	case NotServing:
+		return "NOT_SERVING"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+type CheckerFunc func(ctx context.Context) error
+
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+type Health struct {
+	l           sync.RWMutex
+	checkers    map[string]Checker
+	subscribers map[int]*subscriber
+	seq         int
+	version     uint64
+	status      Status
+	shutdown    bool
+	interval    time.Duration
+	timeout     time.Duration
+	ref         int
+	cancel      context.CancelFunc
+}
+
+type Option func(*Health)
+
+func Interval(interval time.Duration) Option {
+	return func(h *Health) {
+		h.interval = interval
+	}
+}
+
+func Timeout(tm time.Duration) Option {
+	return func(h *Health) {
+		h.timeout = tm
+	}
+}
+
+func New(opts ...Option) *Health {
+	h := &Health{
+		checkers:    make(map[string]Checker),
+		subscribers: make(map[int]*subscriber),
+		version:     1,
+		status:      Serving,
+		interval:    5 * time.Second,
+		timeout:     time.Second,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Register 注册探针, mysql / redis / kafka consumer等组件实现Checker即可
+func (h *Health) Register(name string, checker Checker) {
+	h.l.Lock()
+	h.checkers[name] = checker
+	h.l.Unlock()
+}
+
+func (h *Health) Unregister(name string) {
+	h.l.Lock()
+	delete(h.checkers, name)
+	h.l.Unlock()
+}
+
+// Subscribe 状态变化时回调, 返回取消订阅函数
+func (h *Health) Subscribe(fn func(Status)) func() {
+	h.l.Lock()
+	h.seq++
+	id := h.seq
+	sub := &subscriber{fn: fn}
+	h.subscribers[id] = sub
+	version, status := h.version, h.status
+	h.l.Unlock()
+	sub.notify(version, status)
+	return func() {
+		h.l.Lock()
+		delete(h.subscribers, id)
+		h.l.Unlock()
+	}
+}
+
+func (h *Health) Status() Status {
+	h.l.RLock()
+	defer h.l.RUnlock()
+	return h.status
+}
+
+// Check 执行全部探针并更新状态, 返回每个探针的错误
+func (h *Health) Check(ctx context.Context) (Status, map[string]error) {
+	h.l.RLock()
+	checkers := make(map[string]Checker, len(h.checkers))
+	for name, checker := range h.checkers {
+		checkers[name] = checker
+	}
+	h.l.RUnlock()
+
+	result := make(map[string]error, len(checkers))
+	l := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	for name, checker := range checkers {
+		wg.Add(1)
+		go func(name string, checker Checker) {
+			defer wg.Done()
+			cx, cancel := context.WithTimeout(ctx, h.timeout)
+			defer cancel()
+			err := checker.Check(cx)
+			l.Lock()
+			result[name] = err
+			l.Unlock()
+		}(name, checker)
+	}
+	wg.Wait()
+
+	status := Serving
+	for _, err := range result {
+		if err != nil {
+			status = NotServing
+			break
+		}
+	}
+	return h.set(status), result
+}
+
+// Shutdown 服务退出时置为NOT_SERVING, 之后探针结果不再生效
+func (h *Health) Shutdown() {
+	h.l.Lock()
+	h.shutdown = true
+	h.l.Unlock()
+	h.set(NotServing)
+}
+
+func (h *Health) Resume() {
+	h.l.Lock()
+	h.shutdown = false
+	h.l.Unlock()
+	h.Check(context.Background())
+}
+
+func (h *Health) set(status Status) Status {
+	h.l.Lock()
+	if h.shutdown {
+		status = NotServing
+	}
+	if h.status == status {
+		h.l.Unlock()
+		return status
+	}
+	h.status = status
+	h.version++
+	version := h.version
+	subscribers := make([]*subscriber, 0, len(h.subscribers))
+	for _, sub := range h.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	h.l.Unlock()
+	for _, sub := range subscribers {
+		sub.notify(version, status)
+	}
+	return status
+}
+
+// subscriber 按版本号串行回调, 并发Check时丢弃过期状态
+type subscriber struct {
+	l       sync.Mutex
+	version uint64
+	fn      func(Status)
+}
+
+func (s *subscriber) notify(version uint64, status Status) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if version <= s.version {
+		return
+	}
+	s.version = version
+	s.fn(status)
+}
+
+// Start 周期执行探针, 多个server共享时只启动一次
+func (h *Health) Start() {
+	h.l.Lock()
+	defer h.l.Unlock()
+	h.ref++
+	if h.ref > 1 {
+		return
+	}
+	var ctx context.Context
+	ctx, h.cancel = context.WithCancel(context.Background())
+	go h.run(ctx)
+}
+
+func (h *Health) Stop() {
+	h.l.Lock()
+	defer h.l.Unlock()
+	if h.ref == 0 {
+		return
+	}
+	h.ref--
+	if h.ref == 0 {
+		h.cancel()
+	}
+}
+
+func (h *Health) run(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		h.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+var health = New()
+
+func SetHealth(h *Health) {
+	health = h
+}
+
+func GetHealth() *Health {
+	return health
+}
+
+func Register(name string, checker Checker) {
+	health.Register(name, checker)
+}
@@ -0,0 +1,92 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestHealth(t *testing.T) {
+	h := New()
+	var changes []Status
+	unsub := h.Subscribe(func(s Status) {
+		changes = append(changes, s)
+	})
+	defer unsub()
+
+	h.Register("mysql", CheckerFunc(func(ctx context.Context) error { return nil }))
+	status, _ := h.Check(context.TODO())
+	if status != Serving {
+		t.Fatalf("expect SERVING, got %s", status)
+	}
+
+	h.Register("redis", CheckerFunc(func(ctx context.Context) error { return errors.New("dial timeout") }))
+	status, errs := h.Check(context.TODO())
+	if status != NotServing || errs["redis"] == nil || errs["mysql"] != nil {
+		t.Fatalf("unexpected status:%s errs:%v", status, errs)
+	}
+
+	h.Unregister("redis")
+	h.Check(context.TODO())
+	h.Shutdown()
+	if h.Status() != NotServing {
+		t.Fatalf("expect NOT_SERVING after shutdown")
+	}
+	expect := []Status{Serving, NotServing, Serving, NotServing}
+	if len(changes) != len(expect) {
+		t.Fatalf("expect %v, got %v", expect, changes)
+	}
+	for i := range expect {
+		if changes[i] != expect[i] {
+			t.Fatalf("expect %v, got %v", expect, changes)
+		}
+	}
+}
+
+func TestReadinessHandler(t *testing.T) {
+	h := New()
+	h.Register("kafka", CheckerFunc(func(ctx context.Context) error { return nil }))
+	w := httptest.NewRecorder()
+	h.ReadinessHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expect 200, got %d", w.Code)
+	}
+
+	h.Shutdown()
+	w = httptest.NewRecorder()
+	h.ReadinessHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expect 503, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.LivenessHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, LivenessPath, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expect 200, got %d", w.Code)
+	}
+}
+
+func TestSubscribeOrder(t *testing.T) {
+	h := New()
+	var last Status
+	unsub := h.Subscribe(func(s Status) {
+		last = s
+	})
+	defer unsub()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			h.set(Status(i%2 + 1))
+		}(i)
+	}
+	wg.Wait()
+	if last != h.Status() {
+		t.Fatalf("expect %s, got %s", h.Status(), last)
+	}
+}
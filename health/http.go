@@ -0,0 +1,52 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+)
+
+type result struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// LivenessHandler 进程可以处理请求即存活
+func (h *Health) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		write(w, http.StatusOK, &result{Status: Serving.String()})
+	})
+}
+
+// ReadinessHandler 执行全部探针, 退出中或任一探针失败返回503
+func (h *Health) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status, errs := h.Check(r.Context())
+		rsp := &result{
+			Status: status.String(),
+			Checks: make(map[string]string, len(errs)),
+		}
+		for name, err := range errs {
+			if err != nil {
+				rsp.Checks[name] = err.Error()
+			} else {
+				rsp.Checks[name] = "ok"
+			}
+		}
+		code := http.StatusOK
+		if status != Serving {
+			code = http.StatusServiceUnavailable
+		}
+		write(w, code, rsp)
+	})
+}
+
+func write(w http.ResponseWriter, code int, rsp *result) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(rsp)
+}
@@ -85,12 +85,15 @@ func NewProducer(addr []string, topic string, opts ...Option) *Producer {
 }
 
 func (p *Producer) Produce(ctx context.Context, k, v []byte) error {
-	msg := kafka.Message{
+	return p.produce(ctx, kafka.Message{
 		Key:   k,
 		Value: v,
-	}
+	})
+}
+
+func (p *Producer) produce(ctx context.Context, msg kafka.Message) error {
 	if p.executor != nil {
-		return p.executor.Add(msg, len(v))
+		return p.executor.Add(msg, len(msg.Value))
 	}
 	return p.kw.WriteMessages(ctx, msg)
 }
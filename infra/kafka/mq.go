@@ -0,0 +1,111 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM/sarama"
+	"github.com/go-slark/slark/transport/mq"
+	"github.com/segmentio/kafka-go"
+)
+
+// NewPublisher 基于sarama同步producer的mq.Publisher
+func NewPublisher(kp *KafkaProducer) mq.Publisher {
+	return &publisher{kp: kp}
+}
+
+type publisher struct {
+	kp *KafkaProducer
+}
+
+func (p *publisher) Publish(_ context.Context, msg *mq.Message) error {
+	pm := &sarama.ProducerMessage{
+		Topic: msg.Topic,
+		Key:   sarama.ByteEncoder(msg.Key),
+		Value: sarama.ByteEncoder(msg.Value),
+	}
+	for k, values := range msg.Header {
+		for _, v := range values {
+			pm.Headers = append(pm.Headers, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+		}
+	}
+	_, _, err := p.kp.SyncProducer.SendMessage(pm)
+	return err
+}
+
+func (p *publisher) Close() error {
+	p.kp.Close()
+	return nil
+}
+
+// NewGoPublisher 基于kafka-go的mq.Publisher, topic固定为Producer创建时的topic
+func NewGoPublisher(p *Producer) mq.Publisher {
+	return &goPublisher{p: p}
+}
+
+type goPublisher struct {
+	p *Producer
+}
+
+func (g *goPublisher) Publish(ctx context.Context, msg *mq.Message) error {
+	if msg.Topic != g.p.topic {
+		return fmt.Errorf("kafka-go producer topic %s, message topic %s", g.p.topic, msg.Topic)
+	}
+	km := kafka.Message{
+		Key:   msg.Key,
+		Value: msg.Value,
+	}
+	for k, values := range msg.Header {
+		for _, v := range values {
+			km.Headers = append(km.Headers, kafka.Header{Key: k, Value: []byte(v)})
+		}
+	}
+	return g.p.produce(ctx, km)
+}
+
+func (g *goPublisher) Close() error {
+	return g.p.Close()
+}
+
+// NewSubscriber 基于sarama consumer group的mq.Subscriber, k由Subscriber独占, 不应再直接Register/Consume
+// tracing由mq.Server的middleware负责, 会将k.Tracer置空避免重复埋点
+func NewSubscriber(k *KafkaConsumerGroup) mq.Subscriber {
+	k.Tracer = nil
+	return &subscriber{k: k}
+}
+
+type subscriber struct {
+	k *KafkaConsumerGroup
+}
+
+func (s *subscriber) Subscribe(ctx context.Context, topics []string, handler mq.Handler) error {
+	for _, topic := range topics {
+		s.k.Register(topic, consumeFunc(func(ctx context.Context, msg *sarama.ConsumerMessage) error {
+			m := &mq.Message{
+				Topic:  originTopic(msg),
+				Key:    msg.Key,
+				Value:  msg.Value,
+				Header: make(mq.Header, len(msg.Headers)),
+			}
+			for _, h := range msg.Headers {
+				if h != nil {
+					m.Header.Add(string(h.Key), string(h.Value))
+				}
+			}
+			return handler(ctx, m)
+		}))
+	}
+	stop := context.AfterFunc(ctx, s.k.cf)
+	defer stop()
+	s.k.Consume()
+	return ctx.Err()
+}
+
+func (s *subscriber) Close() error {
+	return s.k.Stop(context.TODO())
+}
+
+type consumeFunc func(context.Context, *sarama.ConsumerMessage) error
+
+func (f consumeFunc) Handler(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	return f(ctx, msg)
+}
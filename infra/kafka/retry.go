@@ -0,0 +1,218 @@
+package kafka
+
+import (
+	"context"
+	"github.com/IBM/sarama"
+	"github.com/go-slark/slark/logger"
+	"github.com/go-slark/slark/pkg/retry"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 转发到重试/死信topic时附加的header, 原始header保留
+const (
+	HeaderOriginTopic     = "x-origin-topic"
+	HeaderOriginPartition = "x-origin-partition"
+	HeaderOriginOffset    = "x-origin-offset"
+	HeaderRetryCount      = "x-retry-count"
+	HeaderRetryAt         = "x-retry-at" // unix ms, 重试topic消费前等待至该时间
+	HeaderError           = "x-error"
+)
+
+// 转发失败或无重试/死信topic时的最大退避间隔
+const maxBackoff = 30 * time.Second
+
+type message struct {
+	*sarama.ConsumerMessage
+	done func(int64)
+}
+
+func (m *message) ack() {
+	if m.done != nil {
+		m.done(m.Offset)
+	}
+}
+
+// partitionOffsets 同一分区的消息按key分发到不同worker, 完成顺序不确定, 只提交连续完成的最大offset
+type partitionOffsets struct {
+	l         sync.Mutex
+	topic     string
+	partition int32
+	sess      sarama.ConsumerGroupSession
+	commit    bool
+	pending   []int64
+	finished  map[int64]struct{}
+}
+
+func newPartitionOffsets(topic string, partition int32, sess sarama.ConsumerGroupSession, commit bool) *partitionOffsets {
+	return &partitionOffsets{
+		topic:     topic,
+		partition: partition,
+		sess:      sess,
+		commit:    commit,
+		finished:  make(map[int64]struct{}),
+	}
+}
+
+func (p *partitionOffsets) add(offset int64) {
+	p.l.Lock()
+	p.pending = append(p.pending, offset)
+	p.l.Unlock()
+}
+
+func (p *partitionOffsets) done(offset int64) {
+	p.l.Lock()
+	p.finished[offset] = struct{}{}
+	mark := int64(-1)
+	for len(p.pending) > 0 {
+		head := p.pending[0]
+		if _, ok := p.finished[head]; !ok {
+			break
+		}
+		delete(p.finished, head)
+		p.pending = p.pending[1:]
+		mark = head
+	}
+	p.l.Unlock()
+	if mark < 0 {
+		return
+	}
+	// sarama只会前移offset, 并发mark无需额外排序
+	p.sess.MarkOffset(p.topic, p.partition, mark+1, "")
+	if p.commit {
+		p.sess.Commit()
+	}
+}
+
+// process at-least-once处理, 返回nil时offset可提交
+// 进程内重试耗尽后依次转发到重试topic / 死信topic, 转发失败时按退避重试转发; 均未配置时按退避重试handler,
+// 直到成功或consumer group停止, 此时返回error且不提交offset, 重新分配分区后再次消费
+func (k *KafkaConsumerGroup) process(ctx context.Context, handler Consume, msg *sarama.ConsumerMessage) error {
+	attempts := k.conf.Retry
+	if attempts <= 0 {
+		attempts = 1
+	}
+	err := retry.NewOption(retry.Retry(attempts), retry.Delay(k.conf.RetryDelay*time.Millisecond)).Retry(func() error {
+		return handler.Handler(ctx, msg)
+	})
+	if err == nil {
+		return nil
+	}
+	fields := map[string]interface{}{
+		"error":     err,
+		"topic":     msg.Topic,
+		"partition": msg.Partition,
+		"offset":    msg.Offset,
+	}
+	level := k.level(msg.Topic)
+	topic := k.next(level)
+	if len(topic) == 0 {
+		k.Log(ctx, logger.ErrorLevel, fields, "handle consume msg error, no retry or dead letter topic, retrying")
+		return k.backoff(func() error {
+			return handler.Handler(ctx, msg)
+		})
+	}
+	fields["forward"] = topic
+	cause := err
+	err = k.backoff(func() error {
+		e := k.forward(topic, level+1, msg, cause)
+		if e != nil {
+			fields["forward_error"] = e
+			k.Log(ctx, logger.ErrorLevel, fields, "forward consume msg error, retrying")
+		}
+		return e
+	})
+	if err != nil {
+		return err
+	}
+	k.Log(ctx, logger.WarnLevel, fields, "handle consume msg error, forwarded")
+	return nil
+}
+
+// backoff 按指数退避重试fn直到成功或consumer group停止
+func (k *KafkaConsumerGroup) backoff(fn func() error) error {
+	opts := []retry.Opt{retry.Retry(math.MaxInt), retry.MaxDelay(maxBackoff), retry.Context(k.ctx), retry.Timer(time.After)}
+	if k.conf.RetryDelay > 0 {
+		opts = append(opts, retry.Delay(k.conf.RetryDelay*time.Millisecond))
+	}
+	return retry.NewOption(opts...).Retry(fn)
+}
+
+// level 0:原始topic, i:第i级重试topic
+func (k *KafkaConsumerGroup) level(topic string) int {
+	for i, t := range k.conf.RetryTopics {
+		if t == topic {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func (k *KafkaConsumerGroup) next(level int) string {
+	if level < len(k.conf.RetryTopics) {
+		return k.conf.RetryTopics[level]
+	}
+	return k.conf.DeadLetterTopic
+}
+
+// wait 重试topic的消息在分区内按序等待至x-retry-at, 只暂停当前claim, ctx结束时返回false
+func wait(ctx context.Context, msg *sarama.ConsumerMessage) bool {
+	at, err := strconv.ParseInt(header(msg, HeaderRetryAt), 10, 64)
+	if err != nil {
+		return true
+	}
+	d := time.Until(time.UnixMilli(at))
+	if d <= 0 {
+		return true
+	}
+	tm := time.NewTimer(d)
+	defer tm.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-tm.C:
+		return true
+	}
+}
+
+func (k *KafkaConsumerGroup) forward(topic string, count int, msg *sarama.ConsumerMessage, cause error) error {
+	pm := &sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.ByteEncoder(msg.Key),
+		Value: sarama.ByteEncoder(msg.Value),
+	}
+	c := &producerMsgCarrier{pm}
+	for _, h := range msg.Headers {
+		if h != nil {
+			pm.Headers = append(pm.Headers, sarama.RecordHeader{Key: h.Key, Value: h.Value})
+		}
+	}
+	// 保留首次消费的原始位置
+	if len(header(msg, HeaderOriginTopic)) == 0 {
+		c.Set(HeaderOriginTopic, msg.Topic)
+		c.Set(HeaderOriginPartition, strconv.FormatInt(int64(msg.Partition), 10))
+		c.Set(HeaderOriginOffset, strconv.FormatInt(msg.Offset, 10))
+	}
+	c.Set(HeaderRetryCount, strconv.Itoa(count))
+	c.Set(HeaderError, cause.Error())
+	if topic != k.conf.DeadLetterTopic {
+		delay := retry.BackOff(count, retry.NewOption(retry.Delay(k.conf.RetryDelay*time.Millisecond)))
+		c.Set(HeaderRetryAt, strconv.FormatInt(time.Now().Add(delay).UnixMilli(), 10))
+	}
+	_, _, err := k.producer.SendMessage(pm)
+	return err
+}
+
+func header(msg *sarama.ConsumerMessage, key string) string {
+	return (&consumerMsgCarrier{msg}).Get(key)
+}
+
+// originTopic 重试topic中的消息按原始topic查找handler
+func originTopic(msg *sarama.ConsumerMessage) string {
+	if topic := header(msg, HeaderOriginTopic); len(topic) > 0 {
+		return topic
+	}
+	return msg.Topic
+}
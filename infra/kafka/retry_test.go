@@ -0,0 +1,227 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/go-slark/slark/logger"
+	"sync"
+	"testing"
+	"time"
+)
+
+type session struct {
+	l      sync.Mutex
+	offset int64
+}
+
+func (s *session) Claims() map[string][]int32 { return nil }
+func (s *session) MemberID() string           { return "" }
+func (s *session) GenerationID() int32        { return 0 }
+func (s *session) MarkOffset(_ string, _ int32, offset int64, _ string) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if offset > s.offset {
+		s.offset = offset
+	}
+}
+func (s *session) Commit()                                          {}
+func (s *session) ResetOffset(_ string, _ int32, _ int64, _ string) {}
+func (s *session) MarkMessage(_ *sarama.ConsumerMessage, _ string)  {}
+func (s *session) Context() context.Context                         { return context.Background() }
+
+func TestPartitionOffsets(t *testing.T) {
+	sess := &session{}
+	po := newPartitionOffsets("topic", 0, sess, false)
+	for i := int64(10); i < 14; i++ {
+		po.add(i)
+	}
+	po.done(12)
+	po.done(11)
+	if sess.offset != 0 {
+		t.Fatalf("offset committed before head finished: %d", sess.offset)
+	}
+	po.done(10)
+	if sess.offset != 13 {
+		t.Fatalf("expected 13, got %d", sess.offset)
+	}
+	po.done(13)
+	if sess.offset != 14 {
+		t.Fatalf("expected 14, got %d", sess.offset)
+	}
+}
+
+type consume func(context.Context, *sarama.ConsumerMessage) error
+
+func (f consume) Handler(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	return f(ctx, msg)
+}
+
+func TestProcessForward(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	k := &KafkaConsumerGroup{
+		Logger:   logger.GetLogger(),
+		ctx:      context.Background(),
+		producer: producer,
+		conf: &ConsumerGroupConf{
+			AtLeastOnce:     true,
+			Retry:           2,
+			RetryTopics:     []string{"orders.retry"},
+			DeadLetterTopic: "orders.dlq",
+		},
+	}
+	var calls int
+	handler := consume(func(context.Context, *sarama.ConsumerMessage) error {
+		calls++
+		return errors.New("boom")
+	})
+	check := func(topic, count string) mocks.MessageChecker {
+		return func(pm *sarama.ProducerMessage) error {
+			c := &producerMsgCarrier{pm}
+			if pm.Topic != topic || c.Get(HeaderRetryCount) != count || c.Get(HeaderOriginTopic) != "orders" ||
+				c.Get(HeaderError) != "boom" || c.Get("trace") != "t1" {
+				return fmt.Errorf("unexpected forward message %s %v", pm.Topic, c.Keys())
+			}
+			return nil
+		}
+	}
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(check("orders.retry", "1"))
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(check("orders.dlq", "2"))
+
+	msg := &sarama.ConsumerMessage{Topic: "orders", Offset: 7, Value: []byte("v"),
+		Headers: []*sarama.RecordHeader{{Key: []byte("trace"), Value: []byte("t1")}}}
+	if err := k.process(context.Background(), handler, msg); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected forward to retry topic after 2 attempts, calls=%d", calls)
+	}
+
+	// 重试topic中的消息, 失败后进入死信topic
+	retried := &sarama.ConsumerMessage{Topic: "orders.retry", Value: []byte("v"), Headers: []*sarama.RecordHeader{
+		{Key: []byte("trace"), Value: []byte("t1")},
+		{Key: []byte(HeaderOriginTopic), Value: []byte("orders")},
+		{Key: []byte(HeaderRetryCount), Value: []byte("1")},
+	}}
+	if originTopic(retried) != "orders" {
+		t.Fatal("origin topic header ignored")
+	}
+	if err := k.process(context.Background(), handler, retried); err != nil {
+		t.Fatal(err)
+	}
+	_ = producer.Close()
+}
+
+type group struct {
+	sarama.ConsumerGroup
+}
+
+func (group) Close() error { return nil }
+
+func TestConsumeDrain(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	producer.ExpectSendMessageAndSucceed()
+	k := &KafkaConsumerGroup{
+		ConsumerGroup: group{},
+		Logger:        logger.GetLogger(),
+		producer:      producer,
+		handlers:      make(map[string]Consume),
+		chs:           []chan *message{make(chan *message, 2)},
+		conf:          &ConsumerGroupConf{AtLeastOnce: true, RetryTopics: []string{"orders.retry"}},
+	}
+	k.ctx, k.cf = context.WithCancel(context.Background())
+	k.wg.Add(1)
+	go func() {
+		defer k.wg.Done()
+		k.consume(k.chs[0])
+	}()
+
+	start := make(chan struct{})
+	k.Register("orders", consume(func(context.Context, *sarama.ConsumerMessage) error {
+		<-start
+		return errors.New("boom")
+	}))
+	sess := &session{}
+	po := newPartitionOffsets("orders", 0, sess, false)
+	// orders失败后转发到重试topic并提交, orders.retry未配置死信topic, 重试至停止且不提交offset
+	for i, topic := range []string{"orders", "orders.retry"} {
+		po.add(int64(i))
+		k.chs[0] <- &message{ConsumerMessage: &sarama.ConsumerMessage{Topic: topic, Offset: int64(i), Headers: []*sarama.RecordHeader{
+			{Key: []byte(HeaderOriginTopic), Value: []byte("orders")},
+		}}, done: po.done}
+	}
+	stopped := make(chan struct{})
+	go func() {
+		_ = k.Stop(context.Background())
+		close(stopped)
+	}()
+	close(start)
+	<-stopped
+	// Stop在worker转发完成后才关闭producer, 未满足的期望会导致mock报错
+	if sess.offset != 1 {
+		t.Fatalf("expected offset 1, got %d", sess.offset)
+	}
+}
+
+func TestForwardFail(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	producer.ExpectSendMessageAndFail(sarama.ErrNotEnoughReplicas)
+	producer.ExpectSendMessageAndFail(sarama.ErrNotEnoughReplicas)
+	forwarded := make(chan struct{}, 2)
+	k := &KafkaConsumerGroup{
+		ConsumerGroup: group{},
+		Logger:        logger.GetLogger(),
+		producer:      &failProducer{SyncProducer: producer, sent: forwarded},
+		handlers:      make(map[string]Consume),
+		chs:           []chan *message{make(chan *message, 1)},
+		conf:          &ConsumerGroupConf{AtLeastOnce: true, RetryDelay: 50, DeadLetterTopic: "orders.dlq"},
+	}
+	k.ctx, k.cf = context.WithCancel(context.Background())
+	k.wg.Add(1)
+	go func() {
+		defer k.wg.Done()
+		k.consume(k.chs[0])
+	}()
+
+	k.Register("orders", consume(func(context.Context, *sarama.ConsumerMessage) error {
+		return errors.New("boom")
+	}))
+	sess := &session{}
+	po := newPartitionOffsets("orders", 0, sess, false)
+	po.add(0)
+	k.chs[0] <- &message{ConsumerMessage: &sarama.ConsumerMessage{Topic: "orders"}, done: po.done}
+	// 第二次转发失败后停止, 退避等待随ctx结束
+	<-forwarded
+	<-forwarded
+	_ = k.Stop(context.Background())
+	if sess.offset != 0 {
+		t.Fatalf("offset committed after forward failure: %d", sess.offset)
+	}
+}
+
+type failProducer struct {
+	sarama.SyncProducer
+	sent chan struct{}
+}
+
+func (p *failProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	partition, offset, err := p.SyncProducer.SendMessage(msg)
+	p.sent <- struct{}{}
+	return partition, offset, err
+}
+
+func TestWait(t *testing.T) {
+	msg := &sarama.ConsumerMessage{Headers: []*sarama.RecordHeader{
+		{Key: []byte(HeaderRetryAt), Value: []byte(fmt.Sprint(time.Now().Add(time.Hour).UnixMilli()))},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if wait(ctx, msg) {
+		t.Fatal("wait must return on ctx done")
+	}
+	if !wait(context.Background(), &sarama.ConsumerMessage{}) {
+		t.Fatal("message without retry-at must not wait")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/zhenjl/cityhash"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"sync"
 	"time"
 )
 
@@ -45,6 +46,13 @@ type ConsumerGroupConf struct {
 	WorkerNum    uint          `json:"worker_num"`
 	Version      string        `json:"version"`
 	Worker       int           `json:"worker"`
+	// at-least-once: handler成功后才提交offset
+	AtLeastOnce bool          `json:"at_least_once"`
+	Retry       int           `json:"retry"`       // 进程内最大尝试次数
+	RetryDelay  time.Duration `json:"retry_delay"` // ms
+	// 重试topic阶梯, 失败消息依次投递到下一级, 最后一级失败后投递到死信topic
+	RetryTopics     []string `json:"retry_topics"`
+	DeadLetterTopic string   `json:"dead_letter_topic"`
 }
 
 type KafkaConf struct {
@@ -197,7 +205,13 @@ type KafkaConsumerGroup struct {
 	cf       context.CancelFunc
 	handlers map[string]Consume
 	worker   int
-	chs      []chan *sarama.ConsumerMessage
+	chs      []chan *message
+	wg       sync.WaitGroup
+	once     sync.Once
+	l        sync.RWMutex
+	err      error
+	conf     *ConsumerGroupConf
+	producer sarama.SyncProducer
 }
 
 func NewKafkaConsumer(conf *ConsumerGroupConf, opts ...tracing.Option) (*KafkaConsumerGroup, error) {
@@ -207,18 +221,33 @@ func NewKafkaConsumer(conf *ConsumerGroupConf, opts ...tracing.Option) (*KafkaCo
 	}
 	k := &KafkaConsumerGroup{
 		ConsumerGroup: cg,
-		topics:        conf.Topics,
+		topics:        append(append([]string(nil), conf.Topics...), conf.RetryTopics...),
 		Logger:        logger.GetLogger(),
 		handlers:      make(map[string]Consume),
 		worker:        conf.Worker,
-		chs:           make([]chan *sarama.ConsumerMessage, conf.Worker),
+		chs:           make([]chan *message, conf.Worker),
 		Tracer:        tracing.NewTracer(trace.SpanKindConsumer, opts...),
+		conf:          conf,
+	}
+	if len(conf.RetryTopics) > 0 || len(conf.DeadLetterTopic) > 0 {
+		k.producer, err = newSyncProducer(&ProducerConf{
+			Brokers: conf.Brokers,
+			Retry:   3,
+			Ack:     int16(sarama.WaitForAll),
+			Version: conf.Version,
+		})
+		if err != nil {
+			_ = cg.Close()
+			return nil, err
+		}
 	}
 	k.ConsumerGroupHandler = k
 	for i := 0; i < k.worker; i++ {
-		ch := make(chan *sarama.ConsumerMessage, 1024)
+		ch := make(chan *message, 1024)
 		k.chs[i] = ch
+		k.wg.Add(1)
 		routine.GoSafe(context.TODO(), func() {
+			defer k.wg.Done()
 			k.consume(ch)
 		})
 	}
@@ -251,10 +280,25 @@ func (k *KafkaConsumerGroup) Register(topic string, handler Consume) {
 func (*KafkaConsumerGroup) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
 func (*KafkaConsumerGroup) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
 func (k *KafkaConsumerGroup) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	var po *partitionOffsets
+	if k.conf.AtLeastOnce {
+		po = newPartitionOffsets(claim.Topic(), claim.Partition(), sess, !k.conf.AutoCommit)
+	}
 	for msg := range claim.Messages() {
+		if k.level(msg.Topic) > 0 && !wait(sess.Context(), msg) {
+			return nil
+		}
 		index := cityhash.CityHash32(msg.Key, uint32(len(msg.Key))) % uint32(k.worker)
-		k.chs[index] <- msg
-		sess.MarkMessage(msg, "")
+		m := &message{ConsumerMessage: msg}
+		if po != nil {
+			// 按分发顺序登记, worker完成后提交连续的最大offset
+			po.add(msg.Offset)
+			m.done = po.done
+		}
+		k.chs[index] <- m
+		if po == nil {
+			sess.MarkMessage(msg, "")
+		}
 	}
 	return nil
 }
@@ -262,6 +306,9 @@ func (k *KafkaConsumerGroup) ConsumeClaim(sess sarama.ConsumerGroupSession, clai
 func (k *KafkaConsumerGroup) Consume() {
 	for {
 		err := k.ConsumerGroup.Consume(k.ctx, k.topics, k.ConsumerGroupHandler)
+		k.l.Lock()
+		k.err = err
+		k.l.Unlock()
 		if err != nil {
 			k.Log(k.ctx, logger.WarnLevel, map[string]interface{}{"error": err}, "consumer group consume fail")
 		}
@@ -273,12 +320,13 @@ func (k *KafkaConsumerGroup) Consume() {
 	}
 }
 
-func (k *KafkaConsumerGroup) consume(ch <-chan *sarama.ConsumerMessage) {
-	for {
-		msg := <-ch
-		handler, ok := k.handlers[msg.Topic]
+func (k *KafkaConsumerGroup) consume(ch <-chan *message) {
+	for m := range ch {
+		msg := m.ConsumerMessage
+		handler, ok := k.handlers[originTopic(msg)]
 		if !ok {
 			k.Log(context.TODO(), logger.WarnLevel, map[string]interface{}{"topic": msg.Topic}, "topic unregister")
+			m.ack()
 			continue
 		}
 
@@ -292,11 +340,30 @@ func (k *KafkaConsumerGroup) consume(ch <-chan *sarama.ConsumerMessage) {
 			ctx, span = k.Tracer.Start(context.TODO(), "kafka group consume", &consumerMsgCarrier{msg}, opt...)
 			span.End()
 		}
-		err := handler.Handler(ctx, msg)
-		if err != nil {
-			k.Log(ctx, logger.ErrorLevel, map[string]interface{}{"error": err}, "handle consume msg error")
+		if !k.conf.AtLeastOnce {
+			err := handler.Handler(ctx, msg)
+			if err != nil {
+				k.Log(ctx, logger.ErrorLevel, map[string]interface{}{"error": err}, "handle consume msg error")
+			}
+			continue
+		}
+		if err := k.process(ctx, handler, msg); err != nil {
+			// 不提交offset, 分区重新分配后从该消息继续消费
+			k.Log(ctx, logger.ErrorLevel, map[string]interface{}{"error": err, "topic": msg.Topic, "offset": msg.Offset}, "consume msg unfinished, offset not committed")
+			continue
 		}
+		m.ack()
+	}
+}
+
+// Check 健康检查探针, 最近一次consume失败或已退出视为不健康
+func (k *KafkaConsumerGroup) Check(_ context.Context) error {
+	if err := k.ctx.Err(); err != nil {
+		return err
 	}
+	k.l.RLock()
+	defer k.l.RUnlock()
+	return k.err
 }
 
 func (k *KafkaConsumerGroup) Start() error {
@@ -304,9 +371,21 @@ func (k *KafkaConsumerGroup) Start() error {
 	return nil
 }
 
+// Stop 关闭consumer group后等待worker处理完已分发的消息, 最后关闭重试/死信producer
 func (k *KafkaConsumerGroup) Stop(_ context.Context) error {
-	k.cf()
-	return k.Close()
+	var err error
+	k.once.Do(func() {
+		k.cf()
+		err = k.Close()
+		for _, ch := range k.chs {
+			close(ch)
+		}
+		k.wg.Wait()
+		if k.producer != nil {
+			_ = k.producer.Close()
+		}
+	})
+	return err
 }
 
 var (
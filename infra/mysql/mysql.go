@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"context"
 	xlogger "github.com/go-slark/slark/logger"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -70,6 +71,15 @@ func (c *Client) Database() *gorm.DB {
 	return c.DB
 }
 
+// Check 健康检查探针
+func (c *Client) Check(ctx context.Context) error {
+	sqlDB, err := c.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
 func (c *Client) Close() {
 	sqlDB, err := c.DB.DB()
 	if err != nil {
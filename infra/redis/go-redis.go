@@ -69,6 +69,11 @@ func NewClient(c *Config) (*Client, error) {
 	return &Client{Client: client}, err
 }
 
+// Check 健康检查探针
+func (c *Client) Check(ctx context.Context) error {
+	return c.Ping(ctx).Err()
+}
+
 func (c *Client) Close() error {
 	return c.Client.Close()
 }
@@ -0,0 +1,173 @@
+package logger
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Policy 缓冲区满时的处理策略
+type Policy int
+
+const (
+	// PolicyDrop 丢弃新日志, 不阻塞业务
+	PolicyDrop Policy = iota
+	// PolicyBlock 阻塞等待, 不丢日志
+	PolicyBlock
+)
+
+var ErrClosed = errors.New("logger writer closed")
+
+// Async 异步写, 日志先进入有界队列, 后台goroutine批量写入
+type Async struct {
+	w        io.Writer
+	buf      *bufio.Writer
+	queue    chan []byte
+	policy   Policy
+	interval time.Duration
+	dropped  atomic.Uint64
+	written  atomic.Uint64
+	l        sync.RWMutex
+	closed   bool
+	done     chan struct{}
+}
+
+type asyncOption struct {
+	size     int
+	buffer   int
+	policy   Policy
+	interval time.Duration
+}
+
+type AsyncOption func(*asyncOption)
+
+// QueueSize 队列可容纳的日志条数
+func QueueSize(size int) AsyncOption {
+	return func(o *asyncOption) {
+		o.size = size
+	}
+}
+
+// BufferSize 批量写入的缓冲字节数
+func BufferSize(size int) AsyncOption {
+	return func(o *asyncOption) {
+		o.buffer = size
+	}
+}
+
+func WithPolicy(policy Policy) AsyncOption {
+	return func(o *asyncOption) {
+		o.policy = policy
+	}
+}
+
+// FlushInterval 队列空闲时最长的刷盘间隔
+func FlushInterval(interval time.Duration) AsyncOption {
+	return func(o *asyncOption) {
+		o.interval = interval
+	}
+}
+
+func NewAsync(w io.Writer, opts ...AsyncOption) *Async {
+	o := &asyncOption{
+		size:     8192,
+		buffer:   256 << 10,
+		policy:   PolicyDrop,
+		interval: time.Second,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	a := &Async{
+		w:        w,
+		buf:      bufio.NewWriterSize(w, o.buffer),
+		queue:    make(chan []byte, o.size),
+		policy:   o.policy,
+		interval: o.interval,
+		done:     make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+// Write p可能被调用方复用(如logrus buffer pool), 入队前复制
+func (a *Async) Write(p []byte) (int, error) {
+	a.l.RLock()
+	defer a.l.RUnlock()
+	if a.closed {
+		return 0, ErrClosed
+	}
+	b := make([]byte, len(p))
+	copy(b, p)
+	if a.policy == PolicyBlock {
+		a.queue <- b
+		return len(p), nil
+	}
+	select {
+	case a.queue <- b:
+	default:
+		a.dropped.Add(1)
+	}
+	return len(p), nil
+}
+
+// Dropped 队列满丢弃的日志条数
+func (a *Async) Dropped() uint64 {
+	return a.dropped.Load()
+}
+
+// Written 已写入下游的日志条数
+func (a *Async) Written() uint64 {
+	return a.written.Load()
+}
+
+func (a *Async) run() {
+	defer close(a.done)
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case b, ok := <-a.queue:
+			if !ok {
+				_ = a.buf.Flush()
+				return
+			}
+			a.write(b)
+			// 队列空时刷盘, 降低延迟
+			if len(a.queue) == 0 {
+				_ = a.buf.Flush()
+			}
+		case <-ticker.C:
+			_ = a.buf.Flush()
+		}
+	}
+}
+
+func (a *Async) write(b []byte) {
+	_, err := a.buf.Write(b)
+	if err != nil {
+		a.dropped.Add(1)
+		return
+	}
+	a.written.Add(1)
+}
+
+// Close 写完队列中的日志后关闭下游writer
+func (a *Async) Close() error {
+	a.l.Lock()
+	if a.closed {
+		a.l.Unlock()
+		return nil
+	}
+	a.closed = true
+	close(a.queue)
+	a.l.Unlock()
+	<-a.done
+	if c, ok := a.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
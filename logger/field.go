@@ -1,5 +1,7 @@
 package logger
 
+import "time"
+
 // 日志字段收敛
 
 const (
@@ -22,3 +24,44 @@ func SetField(key string, value interface{}) Field {
 		Value: value,
 	}
 }
+
+func String(key, value string) Field {
+	return Field{Key: key, Value: value}
+}
+
+func Int(key string, value int) Field {
+	return Field{Key: key, Value: value}
+}
+
+func Int64(key string, value int64) Field {
+	return Field{Key: key, Value: value}
+}
+
+func Float64(key string, value float64) Field {
+	return Field{Key: key, Value: value}
+}
+
+func Bool(key string, value bool) Field {
+	return Field{Key: key, Value: value}
+}
+
+func Duration(key string, value time.Duration) Field {
+	return Field{Key: key, Value: value}
+}
+
+func Any(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Err 字段名为err
+func Err(err error) Field {
+	return Field{Key: Error, Value: err}
+}
+
+func toMap(fields []Field) map[string]interface{} {
+	mp := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		mp[f.Key] = f.Value
+	}
+	return mp
+}
@@ -0,0 +1,178 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+)
+
+// Helper 在Logger基础上提供With及Debugf / Infow等方法, logger为nil时使用全局logger
+type Helper struct {
+	logger Logger
+	module string
+	fields []Field
+}
+
+func NewHelper(l Logger, fields ...Field) *Helper {
+	h := &Helper{logger: l}
+	return h.with(fields)
+}
+
+// With 基于全局logger
+func With(fields ...Field) *Helper {
+	return NewHelper(nil, fields...)
+}
+
+// Named 模块日志, 级别受SetModuleLevel控制
+func Named(module string) *Helper {
+	return With(String(Module, module))
+}
+
+func (h *Helper) With(fields ...Field) *Helper {
+	return h.with(fields)
+}
+
+func (h *Helper) with(fields []Field) *Helper {
+	nh := &Helper{
+		logger: h.logger,
+		module: h.module,
+		fields: make([]Field, 0, len(h.fields)+len(fields)),
+	}
+	nh.fields = append(nh.fields, h.fields...)
+	nh.fields = append(nh.fields, fields...)
+	for _, f := range fields {
+		if f.Key != Module {
+			continue
+		}
+		if mod, ok := f.Value.(string); ok {
+			nh.module = mod
+		}
+	}
+	return nh
+}
+
+func (h *Helper) Enabled(level uint) bool {
+	return Enabled(h.module, level)
+}
+
+// Log 实现Logger, fields与绑定的字段合并
+func (h *Helper) Log(ctx context.Context, level uint, fields map[string]interface{}, v ...interface{}) {
+	if !h.Enabled(level) {
+		return
+	}
+	mp := make(map[string]interface{}, len(h.fields)+len(fields))
+	for _, f := range h.fields {
+		mp[f.Key] = f.Value
+	}
+	for k, f := range fields {
+		mp[k] = f
+	}
+	h.get().Log(ctx, level, mp, v...)
+}
+
+func (h *Helper) Logw(ctx context.Context, level uint, msg string, fields ...Field) {
+	if !h.Enabled(level) {
+		return
+	}
+	if len(h.fields) > 0 {
+		fields = append(h.fields[:len(h.fields):len(h.fields)], fields...)
+	}
+	l := h.get()
+	if fl, ok := l.(FieldLogger); ok {
+		fl.Logw(ctx, level, msg, fields...)
+		return
+	}
+	l.Log(ctx, level, toMap(fields), msg)
+}
+
+func (h *Helper) get() Logger {
+	if h.logger == nil {
+		return GetLogger()
+	}
+	return h.logger
+}
+
+func (h *Helper) logf(ctx context.Context, level uint, format string, v []interface{}) {
+	if !h.Enabled(level) {
+		return
+	}
+	h.Logw(ctx, level, fmt.Sprintf(format, v...))
+}
+
+func (h *Helper) log(ctx context.Context, level uint, v []interface{}) {
+	if !h.Enabled(level) {
+		return
+	}
+	h.Logw(ctx, level, fmt.Sprint(v...))
+}
+
+func (h *Helper) Trace(ctx context.Context, v ...interface{}) {
+	h.log(ctx, TraceLevel, v)
+}
+
+func (h *Helper) Tracef(ctx context.Context, format string, v ...interface{}) {
+	h.logf(ctx, TraceLevel, format, v)
+}
+
+func (h *Helper) Tracew(ctx context.Context, msg string, fields ...Field) {
+	h.Logw(ctx, TraceLevel, msg, fields...)
+}
+
+func (h *Helper) Debug(ctx context.Context, v ...interface{}) {
+	h.log(ctx, DebugLevel, v)
+}
+
+func (h *Helper) Debugf(ctx context.Context, format string, v ...interface{}) {
+	h.logf(ctx, DebugLevel, format, v)
+}
+
+func (h *Helper) Debugw(ctx context.Context, msg string, fields ...Field) {
+	h.Logw(ctx, DebugLevel, msg, fields...)
+}
+
+func (h *Helper) Info(ctx context.Context, v ...interface{}) {
+	h.log(ctx, InfoLevel, v)
+}
+
+func (h *Helper) Infof(ctx context.Context, format string, v ...interface{}) {
+	h.logf(ctx, InfoLevel, format, v)
+}
+
+func (h *Helper) Infow(ctx context.Context, msg string, fields ...Field) {
+	h.Logw(ctx, InfoLevel, msg, fields...)
+}
+
+func (h *Helper) Warn(ctx context.Context, v ...interface{}) {
+	h.log(ctx, WarnLevel, v)
+}
+
+func (h *Helper) Warnf(ctx context.Context, format string, v ...interface{}) {
+	h.logf(ctx, WarnLevel, format, v)
+}
+
+func (h *Helper) Warnw(ctx context.Context, msg string, fields ...Field) {
+	h.Logw(ctx, WarnLevel, msg, fields...)
+}
+
+func (h *Helper) Error(ctx context.Context, v ...interface{}) {
+	h.log(ctx, ErrorLevel, v)
+}
+
+func (h *Helper) Errorf(ctx context.Context, format string, v ...interface{}) {
+	h.logf(ctx, ErrorLevel, format, v)
+}
+
+func (h *Helper) Errorw(ctx context.Context, msg string, fields ...Field) {
+	h.Logw(ctx, ErrorLevel, msg, fields...)
+}
+
+func (h *Helper) Fatal(ctx context.Context, v ...interface{}) {
+	h.log(ctx, FatalLevel, v)
+}
+
+func (h *Helper) Fatalf(ctx context.Context, format string, v ...interface{}) {
+	h.logf(ctx, FatalLevel, format, v)
+}
+
+func (h *Helper) Fatalw(ctx context.Context, msg string, fields ...Field) {
+	h.Logw(ctx, FatalLevel, msg, fields...)
+}
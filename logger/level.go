@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var levelName = []string{"panic", "fatal", "error", "warn", "info", "debug", "trace"}
+
+// ParseLevel 兼容warning
+func ParseLevel(level string) (uint, error) {
+	lv := strings.ToLower(strings.TrimSpace(level))
+	if lv == "warning" {
+		lv = "warn"
+	}
+	for i, name := range levelName {
+		if name == lv {
+			return uint(i), nil
+		}
+	}
+	return 0, fmt.Errorf("logger invalid level: %s", level)
+}
+
+func LevelString(level uint) string {
+	if level >= uint(len(levelName)) {
+		return "unknown"
+	}
+	return levelName[level]
+}
+
+// 全局及模块级别, 运行时可修改; 模块由日志字段mod区分
+var levels = struct {
+	global atomic.Uint32
+	module sync.Map
+}{}
+
+func init() {
+	levels.global.Store(uint32(DebugLevel))
+}
+
+func SetLevel(level uint) {
+	levels.global.Store(uint32(level))
+}
+
+func GetLevel() uint {
+	return uint(levels.global.Load())
+}
+
+// SetModuleLevel 覆盖模块的日志级别, 如单独打开redis模块的debug日志
+func SetModuleLevel(module string, level uint) {
+	levels.module.Store(module, level)
+}
+
+func ResetModuleLevel(module string) {
+	levels.module.Delete(module)
+}
+
+// ModuleLevels 当前所有模块级别覆盖
+func ModuleLevels() map[string]uint {
+	mp := make(map[string]uint)
+	levels.module.Range(func(k, v any) bool {
+		mp[k.(string)] = v.(uint)
+		return true
+	})
+	return mp
+}
+
+// Enabled module为空时使用全局级别
+func Enabled(module string, level uint) bool {
+	if len(module) > 0 {
+		if lv, ok := levels.module.Load(module); ok {
+			return level <= lv.(uint)
+		}
+	}
+	return level <= GetLevel()
+}
+
+func module(fields map[string]interface{}) string {
+	mod, _ := fields[Module].(string)
+	return mod
+}
+
+func fieldsModule(fields []Field) string {
+	var mod string
+	for i := range fields {
+		if fields[i].Key == Module {
+			mod, _ = fields[i].Value.(string)
+		}
+	}
+	return mod
+}
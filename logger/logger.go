@@ -16,6 +16,11 @@ type Logger interface {
 	Log(ctx context.Context, level uint, fields map[string]interface{}, v ...interface{})
 }
 
+// FieldLogger 可选, 直接使用Field打印, 避免构造map
+type FieldLogger interface {
+	Logw(ctx context.Context, level uint, msg string, fields ...Field)
+}
+
 var logger Logger
 
 func init() {
@@ -31,5 +36,5 @@ func GetLogger() Logger {
 }
 
 func Log(ctx context.Context, level uint, fields map[string]interface{}, v ...interface{}) {
-	logger.Log(ctx, level, fields, v)
+	logger.Log(ctx, level, fields, v...)
 }
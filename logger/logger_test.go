@@ -0,0 +1,147 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func lines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var out []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		mp := make(map[string]any)
+		if err := json.Unmarshal([]byte(line), &mp); err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, mp)
+	}
+	buf.Reset()
+	return out
+}
+
+func TestHelper(t *testing.T) {
+	buf := &bytes.Buffer{}
+	h := NewHelper(NewSlog(SlogWriter(buf), SlogName("svc")), String("uid", "u1"))
+	h.With(Int("n", 1)).Infow(context.TODO(), "login", Err(errors.New("boom")))
+	h.Infof(context.TODO(), "hello %s", "world")
+	h.Log(context.TODO(), WarnLevel, map[string]interface{}{"k": "v"}, "a", "b")
+	out := lines(t, buf)
+	if len(out) != 3 {
+		t.Fatalf("unexpected lines %v", out)
+	}
+	if out[0]["msg"] != "login" || out[0]["uid"] != "u1" || out[0]["n"] != float64(1) || out[0][Error] != "boom" || out[0]["level"] != "info" || out[0]["log-dumper"] != "svc" {
+		t.Fatalf("unexpected entry %v", out[0])
+	}
+	if out[1]["msg"] != "hello world" || out[1]["n"] != nil {
+		t.Fatalf("unexpected entry %v", out[1])
+	}
+	if out[2]["msg"] != "ab" || out[2]["k"] != "v" || out[2]["level"] != "warn" {
+		t.Fatalf("unexpected entry %v", out[2])
+	}
+}
+
+func TestModuleLevel(t *testing.T) {
+	defer SetLevel(GetLevel())
+	defer ResetModuleLevel("redis")
+	buf := &bytes.Buffer{}
+	l := NewSlog(SlogWriter(buf))
+	SetLevel(InfoLevel)
+	redis := NewHelper(l, String(Module, "redis"))
+	mysql := NewHelper(l, String(Module, "mysql"))
+	redis.Debug(context.TODO(), "redis debug")
+	mysql.Debug(context.TODO(), "mysql debug")
+	if len(lines(t, buf)) != 0 {
+		t.Fatal("debug log not filtered")
+	}
+	// 运行时打开redis模块的debug日志
+	SetModuleLevel("redis", DebugLevel)
+	redis.Debug(context.TODO(), "redis debug")
+	mysql.Debug(context.TODO(), "mysql debug")
+	l.Log(context.TODO(), DebugLevel, map[string]interface{}{Module: "redis"}, "raw")
+	out := lines(t, buf)
+	if len(out) != 2 || out[0]["msg"] != "redis debug" || out[1]["msg"] != "raw" {
+		t.Fatalf("unexpected entry %v", out)
+	}
+	if lv, err := ParseLevel("WARNING"); err != nil || lv != WarnLevel || LevelString(lv) != "warn" {
+		t.Fatalf("parse level %v %v", lv, err)
+	}
+}
+
+func TestSample(t *testing.T) {
+	buf := &bytes.Buffer{}
+	h := NewHelper(Sample(NewSlog(SlogWriter(buf)), time.Hour, 2, 3))
+	for i := 0; i < 10; i++ {
+		h.Info(context.TODO(), "hot path")
+	}
+	h.Info(context.TODO(), "other")
+	// 前2条 + 第5 / 8条 + other
+	if n := len(lines(t, buf)); n != 5 {
+		t.Fatalf("unexpected sampled count %d", n)
+	}
+}
+
+func TestSampleKey(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := Sample(NewSlog(SlogWriter(buf)), time.Hour, 1, 0)
+	for i := 0; i < 3; i++ {
+		l.Log(context.TODO(), InfoLevel, nil, "order", i)
+	}
+	if n := len(lines(t, buf)); n != 1 {
+		t.Fatalf("unexpected sampled count %d", n)
+	}
+	// 被丢弃的日志与未开启级别的日志分配相同, 仅为可变参数
+	defer SetLevel(GetLevel())
+	SetLevel(InfoLevel)
+	disabled := testing.AllocsPerRun(100, func() {
+		l.Log(context.TODO(), DebugLevel, nil, "order", 1, time.Second)
+	})
+	dropped := testing.AllocsPerRun(100, func() {
+		l.Log(context.TODO(), InfoLevel, nil, "order", 1, time.Second)
+	})
+	if dropped != disabled {
+		t.Fatalf("dropped log allocs %v, disabled %v", dropped, disabled)
+	}
+}
+
+func TestSampleDisabled(t *testing.T) {
+	defer SetLevel(GetLevel())
+	SetLevel(InfoLevel)
+	buf := &bytes.Buffer{}
+	l := Sample(NewSlog(SlogWriter(buf)), time.Hour, 1, 0).(FieldLogger)
+	// 未开启级别的日志不占用采样额度, 开启后首条仍输出
+	l.Logw(context.TODO(), DebugLevel, "order")
+	SetLevel(DebugLevel)
+	l.Logw(context.TODO(), DebugLevel, "order")
+	if n := len(lines(t, buf)); n != 1 {
+		t.Fatalf("unexpected sampled count %d", n)
+	}
+}
+
+func TestDisabledAllocs(t *testing.T) {
+	defer SetLevel(GetLevel())
+	SetLevel(InfoLevel)
+	h := NewHelper(NewSlog(SlogWriter(io.Discard)), String(Module, "bench"))
+	n := testing.AllocsPerRun(100, func() {
+		h.Debugw(context.TODO(), "debug")
+	})
+	if n != 0 {
+		t.Fatalf("disabled log allocs %v", n)
+	}
+}
+
+func BenchmarkSlogInfow(b *testing.B) {
+	h := NewHelper(NewSlog(SlogWriter(io.Discard)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		h.Infow(context.TODO(), "bench", String("k", "v"), Int("n", i))
+	}
+}
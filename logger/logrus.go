@@ -172,14 +172,20 @@ func NewLog(opts ...FuncOpts) Logger {
 	}
 	l := logrus.StandardLogger()
 	l.SetFormatter(le.formatter)
-	l.SetLevel(le.level)
+	// 级别由Enabled统一判断, 以支持模块级别覆盖
+	SetLevel(uint(le.level))
+	l.SetLevel(logrus.TraceLevel)
 	l.SetOutput(le.writer)
 	l.SetReportCaller(le.reportCaller)
+	l.AddHook(&traceHook{name: le.name})
 	l.AddHook(le)
 	return &log{Logger: l}
 }
 
 func (l *log) Log(ctx context.Context, level uint, fields map[string]interface{}, v ...interface{}) {
+	if !Enabled(module(fields), level) {
+		return
+	}
 	var logrusLevel logrus.Level
 	switch level {
 	case DebugLevel:
@@ -199,7 +205,7 @@ func (l *log) Log(ctx context.Context, level uint, fields map[string]interface{}
 	default:
 		logrusLevel = logrus.DebugLevel
 	}
-	l.WithContext(ctx).WithFields(fields).Log(logrusLevel, v)
+	l.WithContext(ctx).WithFields(fields).Log(logrusLevel, v...)
 }
 
 // logrus opt
@@ -289,10 +295,6 @@ func (l *logEntity) Levels() []logrus.Level {
 }
 
 func (l *logEntity) Fire(entry *logrus.Entry) error {
-	traceID := trace.ExtractTraceID(entry.Context)
-	entry.Data[utils.TraceID] = traceID
-	entry.Data[utils.LogName] = l.name
-
 	// 日志统一分发 es mongo kafka
 	writer, ok := l.writers[entry.Level]
 	if !ok {
@@ -305,3 +307,19 @@ func (l *logEntity) Fire(entry *logrus.Entry) error {
 	_, err = writer.Write(eb)
 	return err
 }
+
+// traceHook 所有级别的日志注入trace_id / span_id, 需在分发hook之前
+type traceHook struct {
+	name string
+}
+
+func (h *traceHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *traceHook) Fire(entry *logrus.Entry) error {
+	entry.Data[utils.TraceID] = trace.ExtractTraceID(entry.Context)
+	entry.Data[utils.SpanID] = trace.ExtractSpanID(entry.Context)
+	entry.Data[utils.LogName] = h.name
+	return nil
+}
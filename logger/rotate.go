@@ -0,0 +1,294 @@
+package logger
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const backupTimeFormat = "20060102T150405.000"
+
+// Rotate 按大小及时间切割的日志文件, 备份文件名: name-20060102T150405.000.ext[.gz]
+type Rotate struct {
+	filename string
+	opt      *rotateOption
+	l        sync.Mutex
+	file     *os.File
+	size     int64
+	next     time.Time
+	mill     chan struct{}
+	done     chan struct{}
+	wg       sync.WaitGroup
+	once     sync.Once
+}
+
+type rotateOption struct {
+	maxSize    int64
+	interval   time.Duration
+	maxAge     time.Duration
+	maxBackups int
+	compress   bool
+	now        func() time.Time
+}
+
+type RotateOption func(*rotateOption)
+
+// MaxSize 单个文件最大字节数, 0不按大小切割
+func MaxSize(size int64) RotateOption {
+	return func(o *rotateOption) {
+		o.maxSize = size
+	}
+}
+
+// Interval 按时间切割, 如time.Hour / 24*time.Hour, 按本地时间对齐
+func Interval(interval time.Duration) RotateOption {
+	return func(o *rotateOption) {
+		o.interval = interval
+	}
+}
+
+// MaxAge 备份文件保留时间, 0不按时间清理
+func MaxAge(age time.Duration) RotateOption {
+	return func(o *rotateOption) {
+		o.maxAge = age
+	}
+}
+
+// MaxBackups 备份文件保留个数, 0不按个数清理
+func MaxBackups(n int) RotateOption {
+	return func(o *rotateOption) {
+		o.maxBackups = n
+	}
+}
+
+// Compress 备份文件gzip压缩
+func Compress(compress bool) RotateOption {
+	return func(o *rotateOption) {
+		o.compress = compress
+	}
+}
+
+func NewRotate(filename string, opts ...RotateOption) *Rotate {
+	o := &rotateOption{
+		maxSize: 100 << 20,
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	r := &Rotate{
+		filename: filename,
+		opt:      o,
+		mill:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	r.wg.Add(1)
+	go r.run()
+	return r
+}
+
+func (r *Rotate) Write(p []byte) (int, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+	if r.file == nil {
+		err := r.open()
+		if err != nil {
+			return 0, err
+		}
+	}
+	now := r.opt.now()
+	if (r.opt.maxSize > 0 && r.size > 0 && r.size+int64(len(p)) > r.opt.maxSize) || (!r.next.IsZero() && !now.Before(r.next)) {
+		err := r.rotate(now)
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Rotate 手动切割, 如收到SIGHUP时
+func (r *Rotate) Rotate() error {
+	r.l.Lock()
+	defer r.l.Unlock()
+	if r.file == nil {
+		return r.open()
+	}
+	return r.rotate(r.opt.now())
+}
+
+func (r *Rotate) Close() error {
+	var err error
+	r.once.Do(func() {
+		close(r.done)
+		r.wg.Wait()
+		r.l.Lock()
+		defer r.l.Unlock()
+		if r.file != nil {
+			err = r.file.Close()
+			r.file = nil
+		}
+	})
+	return err
+}
+
+func (r *Rotate) open() error {
+	err := os.MkdirAll(filepath.Dir(r.filename), 0755)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(r.filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	r.file = f
+	r.size = info.Size()
+	r.next = r.boundary(r.opt.now())
+	if r.size > 0 {
+		// 启动时已存在的文件按修改时间对齐, 上个周期的文件在首次写入时切割
+		r.next = r.boundary(info.ModTime())
+	}
+	return nil
+}
+
+func (r *Rotate) rotate(now time.Time) error {
+	err := r.file.Close()
+	if err != nil {
+		return err
+	}
+	r.file = nil
+	ext := filepath.Ext(r.filename)
+	backup := strings.TrimSuffix(r.filename, ext) + "-" + now.Format(backupTimeFormat) + ext
+	for i := 1; exist(backup) || exist(backup+".gz"); i++ {
+		// 同一毫秒内多次切割
+		backup = strings.TrimSuffix(r.filename, ext) + "-" + now.Add(time.Duration(i)*time.Millisecond).Format(backupTimeFormat) + ext
+	}
+	err = os.Rename(r.filename, backup)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	err = r.open()
+	if err != nil {
+		return err
+	}
+	select {
+	case r.mill <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (r *Rotate) boundary(now time.Time) time.Time {
+	if r.opt.interval <= 0 {
+		return time.Time{}
+	}
+	// Truncate按UTC对齐, 补偿时区偏移
+	_, offset := now.Zone()
+	shift := time.Duration(offset) * time.Second
+	return now.Add(shift).Truncate(r.opt.interval).Add(r.opt.interval - shift)
+}
+
+// run 压缩及清理在后台执行, 不阻塞写日志
+func (r *Rotate) run() {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-r.mill:
+			_ = r.clean()
+		}
+	}
+}
+
+type backup struct {
+	path string
+	tm   time.Time
+}
+
+func (r *Rotate) backups() ([]backup, error) {
+	dir := filepath.Dir(r.filename)
+	ext := filepath.Ext(r.filename)
+	prefix := strings.TrimSuffix(filepath.Base(r.filename), ext) + "-"
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []backup
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		ts := strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(name, prefix), ".gz"), ext)
+		tm, err := time.ParseInLocation(backupTimeFormat, ts, time.Local)
+		if err != nil {
+			continue
+		}
+		files = append(files, backup{path: filepath.Join(dir, name), tm: tm})
+	}
+	// 新的在前
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].tm.After(files[j].tm)
+	})
+	return files, nil
+}
+
+func (r *Rotate) clean() error {
+	files, err := r.backups()
+	if err != nil {
+		return err
+	}
+	cutoff := r.opt.now().Add(-r.opt.maxAge)
+	for i, f := range files {
+		if (r.opt.maxBackups > 0 && i >= r.opt.maxBackups) || (r.opt.maxAge > 0 && f.tm.Before(cutoff)) {
+			_ = os.Remove(f.path)
+			continue
+		}
+		if r.opt.compress && !strings.HasSuffix(f.path, ".gz") {
+			_ = compress(f.path)
+		}
+	}
+	return nil
+}
+
+func exist(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func compress(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(dst)
+	_, err = io.Copy(gz, src)
+	if err == nil {
+		err = gz.Close()
+	}
+	if e := dst.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		_ = os.Remove(path + ".gz")
+		return err
+	}
+	return os.Remove(path)
+}
@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+const buckets = 4096
+
+type counter struct {
+	reset atomic.Int64
+	count atomic.Uint64
+}
+
+// inc tick内的计数, 进入新的tick时重置
+func (c *counter) inc(now time.Time, tick time.Duration) uint64 {
+	tn := now.UnixNano()
+	reset := c.reset.Load()
+	if reset > tn {
+		return c.count.Add(1)
+	}
+	c.count.Store(1)
+	c.reset.Store(tn + tick.Nanoseconds())
+	return 1
+}
+
+type sampler struct {
+	logger     Logger
+	tick       time.Duration
+	first      uint64
+	thereafter uint64
+	counts     [buckets]counter
+}
+
+// Sample 热点路径采样: 每个tick内相同级别及msg(Log为第一个参数)的日志先输出first条, 之后每thereafter条输出1条
+// thereafter为0时tick内超过first条的日志全部丢弃, 即限流
+func Sample(l Logger, tick time.Duration, first, thereafter int) Logger {
+	return &sampler{
+		logger:     l,
+		tick:       tick,
+		first:      uint64(first),
+		thereafter: uint64(thereafter),
+	}
+}
+
+func (s *sampler) allow(level uint, msg string) bool {
+	// fnv-1a, 避免hash.Hash及[]byte转换的内存分配
+	h := uint32(2166136261)
+	h = (h ^ uint32(byte(level))) * 16777619
+	for i := 0; i < len(msg); i++ {
+		h = (h ^ uint32(msg[i])) * 16777619
+	}
+	n := s.counts[h%buckets].inc(time.Now(), s.tick)
+	if n <= s.first {
+		return true
+	}
+	return s.thereafter > 0 && (n-s.first)%s.thereafter == 0
+}
+
+// key 以第一个参数(通常为msg或format)作为采样key, 被丢弃的日志不拼接完整消息
+func key(v []interface{}) string {
+	if len(v) == 0 {
+		return ""
+	}
+	if msg, ok := v[0].(string); ok {
+		return msg
+	}
+	return fmt.Sprint(v[0])
+}
+
+func (s *sampler) Log(ctx context.Context, level uint, fields map[string]interface{}, v ...interface{}) {
+	if !Enabled(module(fields), level) || !s.allow(level, key(v)) {
+		return
+	}
+	s.logger.Log(ctx, level, fields, v...)
+}
+
+func (s *sampler) Logw(ctx context.Context, level uint, msg string, fields ...Field) {
+	if !Enabled(fieldsModule(fields), level) || !s.allow(level, msg) {
+		return
+	}
+	if fl, ok := s.logger.(FieldLogger); ok {
+		fl.Logw(ctx, level, msg, fields...)
+		return
+	}
+	s.logger.Log(ctx, level, toMap(fields), msg)
+}
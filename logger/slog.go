@@ -0,0 +1,189 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-slark/slark/pkg"
+	"github.com/go-slark/slark/pkg/trace"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// slog级别, trace / fatal / panic无对应级别
+const (
+	slogTrace = slog.LevelDebug - 4
+	slogFatal = slog.LevelError + 4
+	slogPanic = slog.LevelError + 8
+)
+
+var slogLevel = []slog.Level{slogPanic, slogFatal, slog.LevelError, slog.LevelWarn, slog.LevelInfo, slog.LevelDebug, slogTrace}
+
+type slogLogger struct {
+	name     string
+	handler  slog.Handler
+	dispatch map[uint]slog.Handler
+}
+
+type slogOption struct {
+	name       string
+	writer     io.Writer
+	handler    slog.Handler
+	source     bool
+	dispatcher map[string]io.Writer
+}
+
+type SlogOption func(*slogOption)
+
+func SlogName(name string) SlogOption {
+	return func(o *slogOption) {
+		o.name = name
+	}
+}
+
+func SlogWriter(w io.Writer) SlogOption {
+	return func(o *slogOption) {
+		o.writer = w
+	}
+}
+
+// SlogHandler 自定义handler, 设置后SlogWriter不生效
+func SlogHandler(h slog.Handler) SlogOption {
+	return func(o *slogOption) {
+		o.handler = h
+	}
+}
+
+func SlogSource(source bool) SlogOption {
+	return func(o *slogOption) {
+		o.source = source
+	}
+}
+
+// SlogDispatcher 按级别额外写入, 与logrus WithDispatcher一致
+func SlogDispatcher(dispatcher map[string]io.Writer) SlogOption {
+	return func(o *slogOption) {
+		o.dispatcher = dispatcher
+	}
+}
+
+// NewSlog 基于log/slog的实现, 级别由Enabled判断, handler级别固定为trace
+func NewSlog(opts ...SlogOption) Logger {
+	o := &slogOption{
+		name:   "default",
+		writer: os.Stdout,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	ho := &slog.HandlerOptions{
+		AddSource: o.source,
+		Level:     slogTrace,
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.LevelKey {
+				a.Value = slog.StringValue(levelText(a.Value.Any().(slog.Level)))
+			}
+			return a
+		},
+	}
+	if o.handler == nil {
+		o.handler = slog.NewJSONHandler(o.writer, ho)
+	}
+	l := &slogLogger{
+		name:     o.name,
+		handler:  o.handler,
+		dispatch: make(map[uint]slog.Handler, len(o.dispatcher)),
+	}
+	for level, w := range o.dispatcher {
+		lv, err := ParseLevel(level)
+		if err != nil {
+			continue
+		}
+		l.dispatch[lv] = slog.NewJSONHandler(w, ho)
+	}
+	return l
+}
+
+func (l *slogLogger) Log(ctx context.Context, level uint, fields map[string]interface{}, v ...interface{}) {
+	if !Enabled(module(fields), level) {
+		return
+	}
+	ctx, lv, ok := l.enabled(ctx, level)
+	if !ok {
+		return
+	}
+	r := l.record(ctx, lv, fmt.Sprint(v...))
+	for k, f := range fields {
+		r.AddAttrs(attr(k, f))
+	}
+	l.handle(ctx, level, r)
+}
+
+// Logw 不构造map, 字段直接写入record
+func (l *slogLogger) Logw(ctx context.Context, level uint, msg string, fields ...Field) {
+	if !Enabled(fieldsModule(fields), level) {
+		return
+	}
+	ctx, lv, ok := l.enabled(ctx, level)
+	if !ok {
+		return
+	}
+	r := l.record(ctx, lv, msg)
+	for i := range fields {
+		r.AddAttrs(attr(fields[i].Key, fields[i].Value))
+	}
+	l.handle(ctx, level, r)
+}
+
+// enabled 自定义handler可能有自己的级别
+func (l *slogLogger) enabled(ctx context.Context, level uint) (context.Context, slog.Level, bool) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	lv := slog.LevelDebug
+	if level < uint(len(slogLevel)) {
+		lv = slogLevel[level]
+	}
+	return ctx, lv, l.handler.Enabled(ctx, lv)
+}
+
+func (l *slogLogger) record(ctx context.Context, lv slog.Level, msg string) slog.Record {
+	r := slog.NewRecord(time.Now(), lv, msg, 0)
+	r.AddAttrs(
+		slog.String(utils.TraceID, trace.ExtractTraceID(ctx)),
+		slog.String(utils.SpanID, trace.ExtractSpanID(ctx)),
+		slog.String(utils.LogName, l.name),
+	)
+	return r
+}
+
+func (l *slogLogger) handle(ctx context.Context, level uint, r slog.Record) {
+	_ = l.handler.Handle(ctx, r)
+	if h, ok := l.dispatch[level]; ok {
+		_ = h.Handle(ctx, r)
+	}
+	// 与logrus保持一致
+	switch level {
+	case FatalLevel:
+		os.Exit(1)
+	case PanicLevel:
+		panic(r.Message)
+	}
+}
+
+func attr(key string, value interface{}) slog.Attr {
+	if err, ok := value.(error); ok {
+		return slog.String(key, err.Error())
+	}
+	return slog.Any(key, value)
+}
+
+func levelText(lv slog.Level) string {
+	for i, l := range slogLevel {
+		if l == lv {
+			return LevelString(uint(i))
+		}
+	}
+	return lv.String()
+}
@@ -0,0 +1,170 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func files(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+func TestRotateSize(t *testing.T) {
+	dir := t.TempDir()
+	r := NewRotate(filepath.Join(dir, "app.log"), MaxSize(10), MaxBackups(2), Compress(true))
+	for i := 0; i < 5; i++ {
+		if _, err := r.Write([]byte("0123456789")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 等待后台清理及压缩
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		names := files(t, dir)
+		var gz int
+		for _, name := range names {
+			if strings.HasSuffix(name, ".gz") {
+				gz++
+			}
+		}
+		if gz == 2 && len(names) == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected files %v", names)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	_ = r.Close()
+}
+
+func TestRotateInterval(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.Local)
+	r := NewRotate(filepath.Join(dir, "app.log"), MaxSize(0), Interval(time.Hour))
+	r.opt.now = func() time.Time { return now }
+	_, _ = r.Write([]byte("a\n"))
+	now = now.Add(40 * time.Minute)
+	_, _ = r.Write([]byte("b\n"))
+	_ = r.Close()
+	names := files(t, dir)
+	if len(names) != 2 {
+		t.Fatalf("unexpected files %v", names)
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "app.log"))
+	if string(data) != "b\n" {
+		t.Fatalf("unexpected content %q", data)
+	}
+}
+
+func TestRotateStale(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.Local)
+	if err := os.WriteFile(path, []byte("a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, now.Add(-time.Hour), now.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	r := NewRotate(path, MaxSize(0), Interval(time.Hour))
+	r.opt.now = func() time.Time { return now }
+	_, _ = r.Write([]byte("b\n"))
+	_ = r.Close()
+	names := files(t, dir)
+	if len(names) != 2 {
+		t.Fatalf("stale file not rotated %v", names)
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != "b\n" {
+		t.Fatalf("unexpected content %q", data)
+	}
+}
+
+type slowWriter struct {
+	l      sync.Mutex
+	buf    bytes.Buffer
+	block  chan struct{}
+	closed bool
+}
+
+func (w *slowWriter) Write(p []byte) (int, error) {
+	<-w.block
+	w.l.Lock()
+	defer w.l.Unlock()
+	return w.buf.Write(p)
+}
+
+func (w *slowWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestAsyncDrop(t *testing.T) {
+	w := &slowWriter{block: make(chan struct{})}
+	a := NewAsync(w, QueueSize(2), BufferSize(1))
+	for i := 0; i < 10; i++ {
+		_, _ = a.Write([]byte("x\n"))
+	}
+	if a.Dropped() == 0 {
+		t.Fatal("no log dropped when queue full")
+	}
+	close(w.block)
+	_ = a.Close()
+	if a.Written()+a.Dropped() != 10 || !w.closed {
+		t.Fatalf("written %d dropped %d", a.Written(), a.Dropped())
+	}
+	if _, err := a.Write([]byte("x")); err != ErrClosed {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestAsyncBlock(t *testing.T) {
+	w := &slowWriter{block: make(chan struct{})}
+	close(w.block)
+	a := NewAsync(w, QueueSize(1), WithPolicy(PolicyBlock))
+	for i := 0; i < 100; i++ {
+		_, _ = a.Write([]byte("x\n"))
+	}
+	_ = a.Close()
+	if a.Dropped() != 0 || w.buf.Len() != 200 {
+		t.Fatalf("dropped %d len %d", a.Dropped(), w.buf.Len())
+	}
+}
+
+func TestTraceInject(t *testing.T) {
+	buf := &bytes.Buffer{}
+	errBuf := &bytes.Buffer{}
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{2},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	l := NewSlog(SlogWriter(buf), SlogDispatcher(map[string]io.Writer{"error": errBuf}))
+	l.Log(ctx, ErrorLevel, nil, "failed")
+	l.Log(ctx, InfoLevel, nil, "ok")
+	out := lines(t, buf)
+	if len(out) != 2 || out[0]["x-trace-id"] != sc.TraceID().String() || out[0]["x-span-id"] != sc.SpanID().String() {
+		t.Fatalf("unexpected entry %v", out)
+	}
+	if dispatched := lines(t, errBuf); len(dispatched) != 1 || dispatched[0]["msg"] != "failed" {
+		t.Fatalf("unexpected dispatch %v", dispatched)
+	}
+}
@@ -16,12 +16,12 @@ func Metadata(pt middleware.PeerType) middleware.Middleware {
 				trans transport.Transporter
 				md    metadata.Metadata
 			)
-			carrier := trans.ReqCarrier()
 			if pt == middleware.Server {
 				trans, ok = transport.FromServerContext(ctx)
 				if !ok {
 					return handler(ctx, req)
 				}
+				carrier := trans.ReqCarrier()
 				md = metadata.Metadata{}
 				for _, key := range carrier.Keys() {
 					if !w.HasPrefix(key) {
@@ -41,11 +41,12 @@ func Metadata(pt middleware.PeerType) middleware.Middleware {
 				if !ok {
 					return handler(ctx, req)
 				}
-				for _, key := range carrier.Keys() {
+				carrier := trans.ReqCarrier()
+				for key, values := range md {
 					if !w.HasPrefix(key) {
 						continue
 					}
-					for _, value := range carrier.Values(key) {
+					for _, value := range values {
 						carrier.Add(key, value)
 					}
 				}
@@ -22,9 +22,10 @@ func Trace(kind trace.SpanKind, opts ...tracing.Option) middleware.Middleware {
 				trans transport.Transporter
 				ok    bool
 			)
-			if kind == trace.SpanKindClient {
+			// 消息队列: producer对应client, consumer对应server
+			if kind == trace.SpanKindClient || kind == trace.SpanKindProducer {
 				trans, ok = transport.FromClientContext(ctx)
-			} else if kind == trace.SpanKindServer {
+			} else if kind == trace.SpanKindServer || kind == trace.SpanKindConsumer {
 				trans, ok = transport.FromServerContext(ctx)
 			}
 			if !ok {
@@ -38,6 +39,8 @@ func Trace(kind trace.SpanKind, opts ...tracing.Option) middleware.Middleware {
 				attrs = attributes(ctx, operation)
 			} else if k == transport.HTTP {
 				attrs = httpAttributes(operation)
+			} else if k == transport.MQ {
+				attrs = []attribute.KeyValue{semconv.MessagingDestinationName(operation)}
 			}
 			opt := []trace.SpanStartOption{
 				trace.WithSpanKind(kind),
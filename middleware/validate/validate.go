@@ -2,6 +2,7 @@ package validate
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/go-slark/slark/errors"
@@ -35,7 +36,7 @@ func Validate() middleware.Middleware {
 				err := validator.ValidateAll()
 				if err != nil {
 					es := err.Error()
-					return nil, errors.BadRequest(es, es).WithError(err)
+					return nil, violations(errors.BadRequest(es, es).WithError(err), err)
 				}
 			} else {
 				m, o := req.(proto.Message)
@@ -45,9 +46,9 @@ func Validate() middleware.Middleware {
 						es := err.Error()
 						str := strings.Split(es, " ")
 						if len(str) == 6 {
-							return nil, errors.BadRequest(str[4], es).WithError(err)
+							return nil, violations(errors.BadRequest(str[4], es).WithError(err), err)
 						}
-						return nil, errors.BadRequest(es, es).WithError(err)
+						return nil, violations(errors.BadRequest(es, es).WithError(err), err)
 					}
 				}
 			}
@@ -55,3 +56,28 @@ func Validate() middleware.Middleware {
 		}
 	}
 }
+
+// violations 校验失败的字段写入BadRequest详情
+func violations(e *errors.Error, err error) *errors.Error {
+	var ve *protovalidate.ValidationError
+	if stderrors.As(err, &ve) {
+		for _, v := range ve.Violations {
+			e = e.WithFieldViolation(v.FieldPath, v.Message)
+		}
+		return e
+	}
+	// protoc-gen-validate: XxxMultiError / XxxValidationError
+	errs := []error{err}
+	if me, ok := err.(interface{ AllErrors() []error }); ok {
+		errs = me.AllErrors()
+	}
+	for _, fe := range errs {
+		if f, ok := fe.(interface {
+			Field() string
+			Reason() string
+		}); ok {
+			e = e.WithFieldViolation(f.Field(), f.Reason())
+		}
+	}
+	return e
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/dtm-labs/rockscache"
+	"github.com/go-slark/slark/logger"
 	"github.com/go-slark/slark/pkg/sf"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
@@ -14,10 +15,15 @@ import (
 // db cache
 
 type Cache struct {
-	rocks  *rockscache.Client
-	sf     *sf.SingleFlight
-	err    error // not found error
-	expiry time.Duration
+	redis   redis.UniversalClient
+	rocks   *rockscache.Client
+	sf      *sf.SingleFlight
+	err     error // not found error
+	expiry  time.Duration
+	local   *Local
+	channel string // 本地缓存失效通知channel
+	stat    *Stat
+	cancel  context.CancelFunc
 }
 
 type Option func(*Cache)
@@ -34,35 +40,94 @@ func Expiry(expiry time.Duration) Option {
 	}
 }
 
+// WithLocal 在redis前增加进程内缓存
+func WithLocal(l *Local) Option {
+	return func(c *Cache) {
+		c.local = l
+	}
+}
+
+// Invalidate Delete / Exec时通过redis pub/sub通知其他实例删除本地缓存
+func Invalidate(channel string) Option {
+	return func(c *Cache) {
+		c.channel = channel
+	}
+}
+
+// WithStat 命中率统计
+func WithStat(name string) Option {
+	return func(c *Cache) {
+		c.stat = NewStat(name)
+	}
+}
+
 func New(redis redis.UniversalClient, opts ...Option) *Cache {
 	c := &Cache{
-		rocks: rockscache.NewClient(redis, rockscache.NewDefaultOptions()),
+		redis:  redis,
+		rocks:  rockscache.NewClient(redis, rockscache.NewDefaultOptions()),
 		err:    gorm.ErrRecordNotFound,
 		expiry: time.Hour * 24 * 7,
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.local != nil && len(c.channel) > 0 {
+		var ctx context.Context
+		ctx, c.cancel = context.WithCancel(context.Background())
+		go c.subscribe(ctx)
+	}
 	return c
 }
 
 func (c *Cache) Fetch(ctx context.Context, key string, v any, fn func(any) error) (bool, error) {
-	var found bool // db from
+	if c.stat != nil {
+		c.stat.IncrementTotal()
+	}
+	if c.local != nil {
+		data, ok := c.local.Get(key)
+		if ok {
+			if c.stat != nil {
+				c.stat.IncrementLocalHit()
+			}
+			if len(data) == 0 {
+				return false, c.err
+			}
+			return false, json.Unmarshal([]byte(data), v)
+		}
+	}
+	var (
+		found  bool // db from
+		loaded bool
+	)
 	data, err := c.rocks.Fetch2(ctx, key, c.expiry, func() (string, error) {
+		loaded = true
 		err := fn(v)
 		if err != nil {
 			if errors.Is(err, c.err) {
 				return "", nil
 			}
+			if c.stat != nil {
+				c.stat.IncrementDbFail()
+			}
 			return "", err
 		}
 		found = true
 		data, err := json.Marshal(v)
 		return string(data), err
 	})
+	if c.stat != nil {
+		if loaded {
+			c.stat.IncrementMiss()
+		} else if err == nil {
+			c.stat.IncrementHit()
+		}
+	}
 	if err != nil {
 		return found, err
 	}
+	if c.local != nil {
+		c.local.Set(key, data)
+	}
 	if len(data) == 0 {
 		return found, c.err
 	}
@@ -88,6 +153,8 @@ func (c *Cache) FetchIndex(ctx context.Context, key string, kf func(any) string,
 			return nil
 		}
 		_ = c.rocks.RawSet(ctx, kf(pk), string(data), c.expiry)
+		// redis中的值已更新, 同时通知其他实例删除本地缓存
+		c.invalidate(ctx, []string{kf(pk)})
 		return nil
 	}
 	_, err = c.Fetch(ctx, kf(pk), v, f)
@@ -105,12 +172,65 @@ func (c *Cache) Exec(ctx context.Context, v any, f func(any) error, keys ...stri
 }
 
 func (c *Cache) Delete(ctx context.Context, key string) error {
-	return c.rocks.TagAsDeleted2(ctx, key)
+	err := c.rocks.TagAsDeleted2(ctx, key)
+	c.invalidate(ctx, []string{key})
+	return err
 }
 
 func (c *Cache) Deletes(ctx context.Context, keys []string) error {
 	if len(keys) == 0 {
 		return nil
 	}
-	return c.rocks.TagAsDeletedBatch2(ctx, keys)
+	err := c.rocks.TagAsDeletedBatch2(ctx, keys)
+	c.invalidate(ctx, keys)
+	return err
+}
+
+// Close 停止失效通知订阅及本地缓存时间轮
+func (c *Cache) Close() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	if c.local != nil {
+		c.local.Close()
+	}
+}
+
+func (c *Cache) invalidate(ctx context.Context, keys []string) {
+	if c.local == nil {
+		return
+	}
+	c.local.Delete(keys...)
+	if len(c.channel) == 0 {
+		return
+	}
+	data, _ := json.Marshal(keys)
+	err := c.redis.Publish(ctx, c.channel, string(data)).Err()
+	if err != nil {
+		logger.Log(ctx, logger.WarnLevel, map[string]interface{}{"error": err, "keys": keys}, "cache invalidate publish error")
+	}
+}
+
+func (c *Cache) subscribe(ctx context.Context) {
+	ps := c.redis.Subscribe(ctx, c.channel)
+	defer func() {
+		_ = ps.Close()
+	}()
+	ch := ps.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var keys []string
+			err := json.Unmarshal([]byte(msg.Payload), &keys)
+			if err != nil {
+				continue
+			}
+			c.local.Delete(keys...)
+		}
+	}
 }
@@ -1,3 +1,376 @@
 package cache
 
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
 // local cache: timing wheel ctrl expire at.
+
+type Policy int
+
+const (
+	LRU Policy = iota
+	LFU
+)
+
+// Local 进程内缓存, 容量有限, 按LRU或LFU淘汰, 过期由时间轮回收
+type Local struct {
+	l       sync.Mutex
+	size    int
+	ttl     time.Duration
+	items   map[string]*entry
+	evictor evictor
+	tw      *timingWheel
+}
+
+type LocalOption func(*localOption)
+
+type localOption struct {
+	size     int
+	ttl      time.Duration
+	policy   Policy
+	interval time.Duration
+	slots    int
+}
+
+func Size(size int) LocalOption {
+	return func(o *localOption) {
+		o.size = size
+	}
+}
+
+func TTL(ttl time.Duration) LocalOption {
+	return func(o *localOption) {
+		o.ttl = ttl
+	}
+}
+
+func WithPolicy(p Policy) LocalOption {
+	return func(o *localOption) {
+		o.policy = p
+	}
+}
+
+// Wheel 时间轮刻度及槽数
+func Wheel(interval time.Duration, slots int) LocalOption {
+	return func(o *localOption) {
+		o.interval = interval
+		o.slots = slots
+	}
+}
+
+func NewLocal(opts ...LocalOption) *Local {
+	o := &localOption{
+		size:     10000,
+		ttl:      time.Minute,
+		policy:   LRU,
+		interval: time.Second,
+		slots:    60,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	lc := &Local{
+		size:  o.size,
+		ttl:   o.ttl,
+		items: make(map[string]*entry, o.size),
+	}
+	if o.policy == LFU {
+		lc.evictor = newLFU()
+	} else {
+		lc.evictor = newLRU()
+	}
+	lc.tw = newTimingWheel(o.interval, o.slots, lc.expire)
+	return lc
+}
+
+type entry struct {
+	key      string
+	value    string
+	expireAt time.Time
+	freq     int
+	elem     *list.Element
+}
+
+func (lc *Local) Get(key string) (string, bool) {
+	lc.l.Lock()
+	defer lc.l.Unlock()
+	e, ok := lc.items[key]
+	if !ok {
+		return "", false
+	}
+	// 时间轮按刻度回收, 读取时再校验一次
+	if time.Now().After(e.expireAt) {
+		lc.remove(e)
+		return "", false
+	}
+	lc.evictor.touch(e)
+	return e.value, true
+}
+
+func (lc *Local) Set(key, value string) {
+	lc.SetWithTTL(key, value, lc.ttl)
+}
+
+func (lc *Local) SetWithTTL(key, value string, ttl time.Duration) {
+	lc.l.Lock()
+	defer lc.l.Unlock()
+	if e, ok := lc.items[key]; ok {
+		e.value = value
+		e.expireAt = time.Now().Add(ttl)
+		lc.evictor.touch(e)
+		lc.tw.add(key, ttl)
+		return
+	}
+	for len(lc.items) >= lc.size && lc.size > 0 {
+		victim := lc.evictor.evict()
+		if victim == nil {
+			break
+		}
+		delete(lc.items, victim.key)
+		lc.tw.remove(victim.key)
+	}
+	e := &entry{key: key, value: value, expireAt: time.Now().Add(ttl)}
+	lc.items[key] = e
+	lc.evictor.add(e)
+	lc.tw.add(key, ttl)
+}
+
+func (lc *Local) Delete(keys ...string) {
+	lc.l.Lock()
+	defer lc.l.Unlock()
+	for _, key := range keys {
+		if e, ok := lc.items[key]; ok {
+			lc.remove(e)
+		}
+	}
+}
+
+func (lc *Local) Len() int {
+	lc.l.Lock()
+	defer lc.l.Unlock()
+	return len(lc.items)
+}
+
+func (lc *Local) Close() {
+	lc.tw.stop()
+}
+
+func (lc *Local) remove(e *entry) {
+	delete(lc.items, e.key)
+	lc.evictor.remove(e)
+	lc.tw.remove(e.key)
+}
+
+func (lc *Local) expire(key string) {
+	lc.l.Lock()
+	defer lc.l.Unlock()
+	e, ok := lc.items[key]
+	if ok && !time.Now().Before(e.expireAt) {
+		lc.remove(e)
+	}
+}
+
+type evictor interface {
+	add(e *entry)
+	touch(e *entry)
+	remove(e *entry)
+	evict() *entry
+}
+
+type lru struct {
+	ll *list.List
+}
+
+func newLRU() *lru {
+	return &lru{ll: list.New()}
+}
+
+func (c *lru) add(e *entry) {
+	e.elem = c.ll.PushFront(e)
+}
+
+func (c *lru) touch(e *entry) {
+	c.ll.MoveToFront(e.elem)
+}
+
+func (c *lru) remove(e *entry) {
+	c.ll.Remove(e.elem)
+}
+
+func (c *lru) evict() *entry {
+	elem := c.ll.Back()
+	if elem == nil {
+		return nil
+	}
+	c.ll.Remove(elem)
+	return elem.Value.(*entry)
+}
+
+// lfu 按访问频次分桶, 同频次内按LRU淘汰
+type lfu struct {
+	freqs map[int]*list.List
+	min   int
+}
+
+func newLFU() *lfu {
+	return &lfu{freqs: make(map[int]*list.List)}
+}
+
+func (c *lfu) bucket(freq int) *list.List {
+	ll, ok := c.freqs[freq]
+	if !ok {
+		ll = list.New()
+		c.freqs[freq] = ll
+	}
+	return ll
+}
+
+func (c *lfu) add(e *entry) {
+	e.freq = 1
+	e.elem = c.bucket(1).PushFront(e)
+	c.min = 1
+}
+
+func (c *lfu) touch(e *entry) {
+	c.detach(e)
+	e.freq++
+	e.elem = c.bucket(e.freq).PushFront(e)
+}
+
+func (c *lfu) detach(e *entry) {
+	ll := c.freqs[e.freq]
+	ll.Remove(e.elem)
+	if ll.Len() == 0 {
+		delete(c.freqs, e.freq)
+		if c.min == e.freq {
+			c.min++
+		}
+	}
+}
+
+func (c *lfu) remove(e *entry) {
+	c.detach(e)
+}
+
+func (c *lfu) evict() *entry {
+	if len(c.freqs) == 0 {
+		return nil
+	}
+	ll, ok := c.freqs[c.min]
+	if !ok {
+		// remove后min可能失效, 重新计算
+		c.min = 0
+		for freq := range c.freqs {
+			if c.min == 0 || freq < c.min {
+				c.min = freq
+			}
+		}
+		ll = c.freqs[c.min]
+	}
+	e := ll.Back().Value.(*entry)
+	c.detach(e)
+	return e
+}
+
+// timingWheel 单层时间轮, 超过一圈的任务记录剩余圈数
+type timingWheel struct {
+	l        sync.Mutex
+	interval time.Duration
+	slots    []map[string]int
+	pos      int
+	index    map[string]int
+	fn       func(key string)
+	ticker   *time.Ticker
+	done     chan struct{}
+	once     sync.Once
+}
+
+func newTimingWheel(interval time.Duration, slots int, fn func(key string)) *timingWheel {
+	if slots <= 0 {
+		slots = 60
+	}
+	if interval <= 0 {
+		interval = time.Second
+	}
+	tw := &timingWheel{
+		interval: interval,
+		slots:    make([]map[string]int, slots),
+		index:    make(map[string]int),
+		fn:       fn,
+		ticker:   time.NewTicker(interval),
+		done:     make(chan struct{}),
+	}
+	for i := range tw.slots {
+		tw.slots[i] = make(map[string]int)
+	}
+	go tw.run()
+	return tw
+}
+
+func (tw *timingWheel) add(key string, ttl time.Duration) {
+	tw.l.Lock()
+	defer tw.l.Unlock()
+	tw.del(key)
+	ticks := int((ttl + tw.interval - 1) / tw.interval)
+	if ticks <= 0 {
+		ticks = 1
+	}
+	n := len(tw.slots)
+	slot := (tw.pos + ticks) % n
+	tw.slots[slot][key] = (ticks - 1) / n
+	tw.index[key] = slot
+}
+
+func (tw *timingWheel) remove(key string) {
+	tw.l.Lock()
+	defer tw.l.Unlock()
+	tw.del(key)
+}
+
+func (tw *timingWheel) del(key string) {
+	if slot, ok := tw.index[key]; ok {
+		delete(tw.slots[slot], key)
+		delete(tw.index, key)
+	}
+}
+
+func (tw *timingWheel) run() {
+	for {
+		select {
+		case <-tw.done:
+			tw.ticker.Stop()
+			return
+		case <-tw.ticker.C:
+			tw.tick()
+		}
+	}
+}
+
+func (tw *timingWheel) tick() {
+	tw.l.Lock()
+	tw.pos = (tw.pos + 1) % len(tw.slots)
+	var expired []string
+	for key, rounds := range tw.slots[tw.pos] {
+		if rounds > 0 {
+			tw.slots[tw.pos][key] = rounds - 1
+			continue
+		}
+		expired = append(expired, key)
+		delete(tw.slots[tw.pos], key)
+		delete(tw.index, key)
+	}
+	tw.l.Unlock()
+	// 回调在锁外执行, 回调内会再次调用remove
+	for _, key := range expired {
+		tw.fn(key)
+	}
+}
+
+func (tw *timingWheel) stop() {
+	tw.once.Do(func() {
+		close(tw.done)
+	})
+}
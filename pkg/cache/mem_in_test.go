@@ -0,0 +1,140 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"testing"
+	"time"
+)
+
+func TestLocalLRU(t *testing.T) {
+	lc := NewLocal(Size(2), TTL(time.Minute))
+	defer lc.Close()
+	lc.Set("a", "1")
+	lc.Set("b", "2")
+	lc.Get("a")
+	lc.Set("c", "3")
+	if _, ok := lc.Get("b"); ok {
+		t.Fatal("least recently used key not evicted")
+	}
+	if v, ok := lc.Get("a"); !ok || v != "1" {
+		t.Fatalf("unexpected a: %q %v", v, ok)
+	}
+}
+
+func TestLocalLFU(t *testing.T) {
+	lc := NewLocal(Size(2), TTL(time.Minute), WithPolicy(LFU))
+	defer lc.Close()
+	lc.Set("a", "1")
+	lc.Set("b", "2")
+	lc.Get("a")
+	lc.Get("a")
+	lc.Get("b")
+	lc.Set("c", "3")
+	if _, ok := lc.Get("b"); ok {
+		t.Fatal("least frequently used key not evicted")
+	}
+	lc.Delete("a")
+	lc.Set("d", "4")
+	if lc.Len() != 2 {
+		t.Fatalf("unexpected len %d", lc.Len())
+	}
+}
+
+func TestLocalExpire(t *testing.T) {
+	lc := NewLocal(TTL(30*time.Millisecond), Wheel(10*time.Millisecond, 4))
+	defer lc.Close()
+	lc.Set("a", "1")
+	lc.SetWithTTL("b", "2", time.Hour)
+	time.Sleep(100 * time.Millisecond)
+	if lc.Len() != 1 {
+		t.Fatalf("expired key not reclaimed by timing wheel, len=%d", lc.Len())
+	}
+	if _, ok := lc.Get("b"); !ok {
+		t.Fatal("key with long ttl removed")
+	}
+}
+
+func newTiered(t *testing.T, addr string) *Cache {
+	c := New(redis.NewClient(&redis.Options{Addr: addr}), WithLocal(NewLocal(TTL(time.Minute))), Invalidate("cache.invalidate"))
+	t.Cleanup(c.Close)
+	return c
+}
+
+// eventually 等待pub/sub通知到达
+func eventually(t *testing.T, cond func() bool, msg string) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal(msg)
+}
+
+func TestLocalTier(t *testing.T) {
+	mr := miniredis.RunT(t)
+	a, b := newTiered(t, mr.Addr()), newTiered(t, mr.Addr())
+	eventually(t, func() bool { return mr.PubSubNumSub("cache.invalidate")["cache.invalidate"] == 2 }, "subscribe")
+
+	ctx := context.Background()
+	var loads int
+	load := func(v any) error {
+		loads++
+		v.(*Value).V = "v1"
+		return nil
+	}
+	for _, c := range []*Cache{a, a, b} {
+		v := &Value{}
+		if _, err := c.Fetch(ctx, "user:1", v, load); err != nil || v.V != "v1" {
+			t.Fatalf("fetch %v %v", v, err)
+		}
+	}
+	if loads != 1 {
+		t.Fatalf("expected one db load, got %d", loads)
+	}
+	// redis被清空后仍由本地缓存命中
+	mr.FlushAll()
+	v := &Value{}
+	if _, err := b.Fetch(ctx, "user:1", v, load); err != nil || v.V != "v1" || loads != 1 {
+		t.Fatalf("local tier missed: %v %v %d", v, err, loads)
+	}
+
+	// a删除后通知b删除本地缓存
+	if err := a.Delete(ctx, "user:1"); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, func() bool {
+		_, ok := b.local.Get("user:1")
+		return !ok
+	}, "delete not propagated to other instance")
+}
+
+func TestFetchIndexInvalidate(t *testing.T) {
+	mr := miniredis.RunT(t)
+	a, b := newTiered(t, mr.Addr()), newTiered(t, mr.Addr())
+	eventually(t, func() bool { return mr.PubSubNumSub("cache.invalidate")["cache.invalidate"] == 2 }, "subscribe")
+
+	ctx := context.Background()
+	b.local.Set("user:1", `{"v":"stale"}`)
+	v := &Value{}
+	err := a.FetchIndex(ctx, "user:email:a@b.c", func(pk any) string {
+		return fmt.Sprintf("user:%v", pk)
+	}, v, func(pk any) error {
+		*pk.(*any) = 1
+		return nil
+	}, func(any) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 主键缓存已在redis中更新, 其他实例的本地缓存需失效
+	eventually(t, func() bool {
+		_, ok := b.local.Get("user:1")
+		return !ok
+	}, "fetch index not propagated to other instance")
+}
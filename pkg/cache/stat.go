@@ -3,16 +3,26 @@ package cache
 import (
 	"context"
 	"github.com/go-slark/slark/logger"
+	"github.com/go-slark/slark/middleware/metrics"
 	"sync/atomic"
 	"time"
 )
 
+var Requests = metrics.NewCounter(
+	metrics.Namespace("cache"),
+	metrics.Name("requests_total"),
+	metrics.Help("cache requests count"),
+	metrics.SubSystem("fetch"),
+	metrics.Labels([]string{"key", "result"}),
+)
+
 type Stat struct {
-	key    string
-	total  uint64
-	hit    uint64
-	miss   uint64
-	dbFail uint64
+	key      string
+	total    uint64
+	hit      uint64
+	localHit uint64
+	miss     uint64
+	dbFail   uint64
 }
 
 func NewStat(key string) *Stat {
@@ -35,15 +45,17 @@ func (s *Stat) loop(ticker *time.Ticker) {
 		}
 		hit := atomic.SwapUint64(&s.hit, 0)
 		ratio := 100 * float64(hit) / float64(total)
+		localHit := atomic.SwapUint64(&s.localHit, 0)
 		miss := atomic.SwapUint64(&s.miss, 0)
 		dbFail := atomic.SwapUint64(&s.dbFail, 0)
 		fields := map[string]interface{}{
-			"key":     s.key,
-			"total":   total,
-			"ratio":   ratio,
-			"hit":     hit,
-			"miss":    miss,
-			"db_fail": dbFail,
+			"key":       s.key,
+			"total":     total,
+			"ratio":     ratio,
+			"hit":       hit,
+			"local_hit": localHit,
+			"miss":      miss,
+			"db_fail":   dbFail,
 		}
 		logger.Log(context.TODO(), logger.DebugLevel, fields, "cache stat")
 	}
@@ -55,12 +67,22 @@ func (s *Stat) IncrementTotal() {
 
 func (s *Stat) IncrementHit() {
 	atomic.AddUint64(&s.hit, 1)
+	Requests.Values(s.key, "hit").Inc()
+}
+
+// IncrementLocalHit 本地缓存命中, 同时计入hit
+func (s *Stat) IncrementLocalHit() {
+	atomic.AddUint64(&s.localHit, 1)
+	atomic.AddUint64(&s.hit, 1)
+	Requests.Values(s.key, "local_hit").Inc()
 }
 
 func (s *Stat) IncrementMiss() {
 	atomic.AddUint64(&s.miss, 1)
+	Requests.Values(s.key, "miss").Inc()
 }
 
 func (s *Stat) IncrementDbFail() {
 	atomic.AddUint64(&s.dbFail, 1)
+	Requests.Values(s.key, "db_fail").Inc()
 }
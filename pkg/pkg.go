@@ -29,9 +29,10 @@ const (
 	XForwardedURI    = "X-Forwarded-Uri"
 	XForwardedIP     = "X-Forwarded-For"
 
-	ContentType = "Content-Type"
-	Accept      = "Accept"
-	Application = "application"
+	ContentType    = "Content-Type"
+	Accept         = "Accept"
+	AcceptLanguage = "Accept-Language"
+	Application    = "application"
 
 	Discovery       = "discovery"
 	Weight          = "weight"
@@ -156,7 +156,8 @@ func (o *Option) Retry(fn func() error) error {
 
 		select {
 		case <-o.timer(delay(o, n)):
-			// TODO
+		case <-o.ctx.Done():
+			return err
 		}
 	}
 	return err
@@ -0,0 +1,267 @@
+package consul
+
+import (
+	"context"
+	"fmt"
+	utils "github.com/go-slark/slark/pkg"
+	"github.com/go-slark/slark/registry"
+	"github.com/hashicorp/consul/api"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const versionTag = "version="
+
+type Registry struct {
+	client *api.Client
+	opt    *option
+	l      sync.Mutex
+	cancel map[string]context.CancelFunc
+}
+
+type option struct {
+	ttl        time.Duration
+	deregister time.Duration
+	wait       time.Duration
+	passing    bool
+}
+
+type Option func(*option)
+
+// TTL 健康检查ttl, 心跳间隔为ttl/2
+func TTL(ttl time.Duration) Option {
+	return func(o *option) {
+		o.ttl = ttl
+	}
+}
+
+// Deregister 健康检查失败多久后consul自动注销服务
+func Deregister(tm time.Duration) Option {
+	return func(o *option) {
+		o.deregister = tm
+	}
+}
+
+// WaitTime blocking query等待时间
+func WaitTime(tm time.Duration) Option {
+	return func(o *option) {
+		o.wait = tm
+	}
+}
+
+// PassingOnly 只发现健康检查通过的实例
+func PassingOnly(passing bool) Option {
+	return func(o *option) {
+		o.passing = passing
+	}
+}
+
+func NewRegistry(client *api.Client, opts ...Option) *Registry {
+	opt := &option{
+		ttl:        10 * time.Second,
+		deregister: time.Minute,
+		wait:       55 * time.Second,
+		passing:    true,
+	}
+	for _, o := range opts {
+		o(opt)
+	}
+	return &Registry{
+		client: client,
+		opt:    opt,
+		cancel: make(map[string]context.CancelFunc),
+	}
+}
+
+func (r *Registry) Register(ctx context.Context, svc *registry.Service) error {
+	tagged := make(map[string]api.ServiceAddress, len(svc.Endpoint))
+	var (
+		addr string
+		port int
+	)
+	for _, ep := range svc.Endpoint {
+		u, err := url.Parse(ep)
+		if err != nil {
+			return err
+		}
+		host, p, err := net.SplitHostPort(u.Host)
+		if err != nil {
+			return err
+		}
+		pt, err := strconv.Atoi(p)
+		if err != nil {
+			return err
+		}
+		if len(addr) == 0 {
+			addr, port = host, pt
+		}
+		tagged[u.Scheme] = api.ServiceAddress{Address: host, Port: pt}
+	}
+	reg := &api.AgentServiceRegistration{
+		ID:              svc.ID,
+		Name:            svc.Name,
+		Tags:            []string{versionTag + svc.Version},
+		Address:         addr,
+		Port:            port,
+		TaggedAddresses: tagged,
+		Meta:            svc.Metadata,
+		Check: &api.AgentServiceCheck{
+			CheckID:                        checkID(svc.ID),
+			TTL:                            r.opt.ttl.String(),
+			DeregisterCriticalServiceAfter: r.opt.deregister.String(),
+		},
+	}
+	w, ok := svc.Metadata[utils.Weight]
+	if ok {
+		weight, err := strconv.Atoi(w)
+		if err == nil {
+			reg.Weights = &api.AgentWeights{Passing: weight, Warning: 1}
+		}
+	}
+	err := r.client.Agent().ServiceRegisterOpts(reg, api.ServiceRegisterOpts{}.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	err = r.client.Agent().UpdateTTLOpts(checkID(svc.ID), "pass", api.HealthPassing, (&api.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	cx, cancel := context.WithCancel(context.Background())
+	r.l.Lock()
+	if c, o := r.cancel[svc.ID]; o {
+		c()
+	}
+	r.cancel[svc.ID] = cancel
+	r.l.Unlock()
+	go r.heartbeat(cx, svc.ID)
+	return nil
+}
+
+func (r *Registry) heartbeat(ctx context.Context, id string) {
+	ticker := time.NewTicker(r.opt.ttl / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = r.client.Agent().UpdateTTLOpts(checkID(id), "pass", api.HealthPassing, (&api.QueryOptions{}).WithContext(ctx))
+		}
+	}
+}
+
+func (r *Registry) Unregister(ctx context.Context, svc *registry.Service) error {
+	r.l.Lock()
+	if cancel, ok := r.cancel[svc.ID]; ok {
+		cancel()
+		delete(r.cancel, svc.ID)
+	}
+	r.l.Unlock()
+	return r.client.Agent().ServiceDeregisterOpts(svc.ID, (&api.QueryOptions{}).WithContext(ctx))
+}
+
+func (r *Registry) Discover(ctx context.Context, name string) (registry.Watcher, error) {
+	w := &watcher{
+		client:  r.client,
+		name:    name,
+		wait:    r.opt.wait,
+		passing: r.opt.passing,
+	}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	return w, nil
+}
+
+type watcher struct {
+	client  *api.Client
+	ctx     context.Context
+	cancel  context.CancelFunc
+	name    string
+	index   uint64
+	wait    time.Duration
+	passing bool
+	tracker registry.Tracker
+}
+
+func (w *watcher) Next(ctx context.Context) (*registry.Snapshot, error) {
+	ctx, cancel := registry.Join(ctx, w.ctx)
+	defer cancel()
+	return w.tracker.Next(ctx, w.fetch)
+}
+
+func (w *watcher) List() ([]*registry.Service, error) {
+	s, err := w.Next(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Services, nil
+}
+
+// fetch blocking query, 服务列表变化或首次调用时返回
+func (w *watcher) fetch(ctx context.Context) ([]*registry.Service, error) {
+	for {
+		opts := (&api.QueryOptions{WaitIndex: w.index, WaitTime: w.wait}).WithContext(ctx)
+		entries, meta, err := w.client.Health().Service(w.name, "", w.passing, opts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, err
+		}
+		prev := w.index
+		w.index = meta.LastIndex
+		if meta.LastIndex < prev {
+			// index回退时重置, 参考consul blocking query
+			w.index = 0
+		}
+		if prev != 0 && meta.LastIndex == prev {
+			continue
+		}
+		return services(entries), nil
+	}
+}
+
+func (w *watcher) Stop() error {
+	w.cancel()
+	return nil
+}
+
+func services(entries []*api.ServiceEntry) []*registry.Service {
+	svc := make([]*registry.Service, 0, len(entries))
+	for _, entry := range entries {
+		s := entry.Service
+		if s == nil {
+			continue
+		}
+		var version string
+		for _, tag := range s.Tags {
+			if strings.HasPrefix(tag, versionTag) {
+				version = strings.TrimPrefix(tag, versionTag)
+			}
+		}
+		endpoint := make([]string, 0, len(s.TaggedAddresses))
+		for scheme, addr := range s.TaggedAddresses {
+			// consul默认的lan/wan地址不是服务注册的endpoint
+			if strings.HasPrefix(scheme, "lan") || strings.HasPrefix(scheme, "wan") {
+				continue
+			}
+			endpoint = append(endpoint, fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(addr.Address, strconv.Itoa(addr.Port))))
+		}
+		svc = append(svc, &registry.Service{
+			ID:       s.ID,
+			Name:     s.Service,
+			Version:  version,
+			Endpoint: endpoint,
+			Metadata: s.Meta,
+		})
+	}
+	return svc
+}
+
+func checkID(id string) string {
+	return "service:" + id
+}
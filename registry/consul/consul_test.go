@@ -0,0 +1,88 @@
+package consul
+
+import (
+	"encoding/json"
+	"github.com/go-slark/slark/registry/registrytest"
+	"github.com/hashicorp/consul/api"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// agent consul agent http api的最小实现
+type agent struct {
+	l        sync.Mutex
+	index    uint64
+	services map[string]*api.AgentServiceRegistration
+	notify   chan struct{}
+}
+
+func (a *agent) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/v1/agent/service/register":
+		reg := &api.AgentServiceRegistration{}
+		_ = json.NewDecoder(r.Body).Decode(reg)
+		a.change(func() { a.services[reg.ID] = reg })
+	case strings.HasPrefix(r.URL.Path, "/v1/agent/service/deregister/"):
+		id := strings.TrimPrefix(r.URL.Path, "/v1/agent/service/deregister/")
+		a.change(func() { delete(a.services, id) })
+	case strings.HasPrefix(r.URL.Path, "/v1/agent/check/update/"):
+	case strings.HasPrefix(r.URL.Path, "/v1/health/service/"):
+		name := strings.TrimPrefix(r.URL.Path, "/v1/health/service/")
+		index, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+		a.l.Lock()
+		notify := a.notify
+		current := a.index
+		a.l.Unlock()
+		if index >= current {
+			select {
+			case <-notify:
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		a.l.Lock()
+		entries := make([]*api.ServiceEntry, 0)
+		for _, reg := range a.services {
+			if reg.Name != name {
+				continue
+			}
+			entries = append(entries, &api.ServiceEntry{Service: &api.AgentService{
+				ID:              reg.ID,
+				Service:         reg.Name,
+				Tags:            reg.Tags,
+				Meta:            reg.Meta,
+				TaggedAddresses: reg.TaggedAddresses,
+			}})
+		}
+		w.Header().Set("X-Consul-Index", strconv.FormatUint(a.index, 10))
+		a.l.Unlock()
+		_ = json.NewEncoder(w).Encode(entries)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (a *agent) change(fn func()) {
+	a.l.Lock()
+	defer a.l.Unlock()
+	fn()
+	a.index++
+	close(a.notify)
+	a.notify = make(chan struct{})
+}
+
+func TestRegistry(t *testing.T) {
+	srv := httptest.NewServer(&agent{index: 1, services: map[string]*api.AgentServiceRegistration{}, notify: make(chan struct{})})
+	defer srv.Close()
+	client, err := api.NewClient(&api.Config{Address: srv.Listener.Addr().String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	registrytest.Run(t, NewRegistry(client, WaitTime(time.Second)))
+}
@@ -190,16 +190,35 @@ type watcher struct {
 	watcher clientv3.Watcher
 	kv      clientv3.KV
 	name    string
+	tracker registry.Tracker
+}
+
+func (w *watcher) Next(ctx context.Context) (*registry.Snapshot, error) {
+	ctx, cancel := registry.Join(ctx, w.ctx)
+	defer cancel()
+	return w.tracker.Next(ctx, w.fetch)
 }
 
 func (w *watcher) List() ([]*registry.Service, error) {
+	s, err := w.Next(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Services, nil
+}
+
+func (w *watcher) fetch(ctx context.Context) ([]*registry.Service, error) {
 	select {
-	case <-w.ctx.Done():
-		return nil, w.ctx.Err()
+	case <-ctx.Done():
+		return nil, ctx.Err()
 
 	case rsp, ok := <-w.wc:
 		if !ok || rsp.Err() != nil {
-			time.Sleep(time.Second)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Second):
+			}
 			_ = w.watcher.Close()
 			w.watcher = clientv3.NewWatcher(w.client)
 			w.wc = w.watcher.Watch(w.ctx, w.key, clientv3.WithPrefix(), clientv3.WithRev(0), clientv3.WithKeysOnly())
@@ -208,12 +227,12 @@ func (w *watcher) List() ([]*registry.Service, error) {
 				return nil, err
 			}
 		}
-		return w.getService()
+		return w.getService(ctx)
 	}
 }
 
-func (w *watcher) getService() ([]*registry.Service, error) {
-	rsp, err := w.kv.Get(w.ctx, w.key, clientv3.WithPrefix())
+func (w *watcher) getService(ctx context.Context) ([]*registry.Service, error) {
+	rsp, err := w.kv.Get(ctx, w.key, clientv3.WithPrefix())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,212 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/encoding/json"
+	"github.com/go-slark/slark/encoding/yaml"
+	"github.com/go-slark/slark/registry"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+/*
+services:
+  - id: user-1
+    name: user-svc
+    version: v1.0.0
+    endpoint: [grpc://127.0.0.1:9090, http://127.0.0.1:8080]
+    metadata: {weight: "100", region: sh}
+*/
+
+type catalog struct {
+	Services []*registry.Service `json:"services" yaml:"services"`
+}
+
+// Registry 基于yaml/json文件的注册中心, 文件变化时通知watcher
+type Registry struct {
+	path  string
+	codec encoding.Codec
+	l     sync.Mutex
+}
+
+func NewRegistry(path string) (*Registry, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	var codec encoding.Codec
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		codec = encoding.GetCodec(yaml.Name)
+	case ".json":
+		codec = encoding.GetCodec(json.Name)
+	default:
+		return nil, errors.New("file registry only support yaml / json")
+	}
+	return &Registry{path: path, codec: codec}, nil
+}
+
+func (r *Registry) load() (*catalog, error) {
+	c := &catalog{}
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		return nil, err
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return c, nil
+	}
+	err = r.codec.Unmarshal(data, c)
+	return c, err
+}
+
+// save 写临时文件后rename, 避免watcher读到写了一半的文件
+func (r *Registry) save(c *catalog) error {
+	data, err := r.codec.Marshal(c)
+	if err != nil {
+		return err
+	}
+	tmp := r.path + ".tmp"
+	err = os.WriteFile(tmp, data, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, r.path)
+}
+
+func (r *Registry) Register(_ context.Context, svc *registry.Service) error {
+	r.l.Lock()
+	defer r.l.Unlock()
+	c, err := r.load()
+	if err != nil {
+		return err
+	}
+	services := make([]*registry.Service, 0, len(c.Services)+1)
+	for _, s := range c.Services {
+		if s.ID != svc.ID || s.Name != svc.Name {
+			services = append(services, s)
+		}
+	}
+	c.Services = append(services, svc)
+	return r.save(c)
+}
+
+func (r *Registry) Unregister(_ context.Context, svc *registry.Service) error {
+	r.l.Lock()
+	defer r.l.Unlock()
+	c, err := r.load()
+	if err != nil {
+		return err
+	}
+	services := make([]*registry.Service, 0, len(c.Services))
+	for _, s := range c.Services {
+		if s.ID != svc.ID || s.Name != svc.Name {
+			services = append(services, s)
+		}
+	}
+	c.Services = services
+	return r.save(c)
+}
+
+func (r *Registry) Discover(ctx context.Context, name string) (registry.Watcher, error) {
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	// 监听目录, 兼容rename / k8s configmap软链接替换
+	err = fw.Add(filepath.Dir(r.path))
+	if err != nil {
+		_ = fw.Close()
+		return nil, err
+	}
+	w := &watcher{
+		r:     r,
+		fw:    fw,
+		name:  name,
+		first: true,
+	}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	return w, nil
+}
+
+type watcher struct {
+	r       *Registry
+	fw      *fsnotify.Watcher
+	ctx     context.Context
+	cancel  context.CancelFunc
+	name    string
+	first   bool
+	tracker registry.Tracker
+}
+
+func (w *watcher) Next(ctx context.Context) (*registry.Snapshot, error) {
+	ctx, cancel := registry.Join(ctx, w.ctx)
+	defer cancel()
+	return w.tracker.Next(ctx, w.fetch)
+}
+
+func (w *watcher) List() ([]*registry.Service, error) {
+	s, err := w.Next(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Services, nil
+}
+
+func (w *watcher) fetch(ctx context.Context) ([]*registry.Service, error) {
+	if !w.first {
+		err := w.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	w.first = false
+	c, err := w.r.load()
+	if err != nil {
+		return nil, err
+	}
+	svc := make([]*registry.Service, 0, len(c.Services))
+	for _, s := range c.Services {
+		if s.Name == w.name {
+			svc = append(svc, s)
+		}
+	}
+	return svc, nil
+}
+
+func (w *watcher) wait(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err, ok := <-w.fw.Errors:
+			if !ok {
+				return context.Canceled
+			}
+			return err
+		case event, ok := <-w.fw.Events:
+			if !ok {
+				return context.Canceled
+			}
+			if filepath.Clean(event.Name) == w.r.path {
+				return nil
+			}
+			// k8s configmap: ..data软链接切换
+			if strings.HasSuffix(event.Name, "..data") {
+				return nil
+			}
+		}
+	}
+}
+
+func (w *watcher) Stop() error {
+	w.cancel()
+	return w.fw.Close()
+}
@@ -0,0 +1,38 @@
+package file
+
+import (
+	"context"
+	"github.com/go-slark/slark/registry/registrytest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "services.yaml")
+	err := os.WriteFile(path, []byte(`
+services:
+  - id: order-1
+    name: order-svc
+    version: v1.0.0
+    endpoint: [grpc://127.0.0.1:9091]
+    metadata: {weight: "100"}
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRegistry(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := r.Discover(context.TODO(), "order-svc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	svc, err := w.List()
+	if err != nil || len(svc) != 1 || svc[0].Metadata["weight"] != "100" {
+		t.Fatalf("unexpected list:%v %v", svc, err)
+	}
+	registrytest.Run(t, r)
+}
@@ -8,10 +8,12 @@ import (
 	"github.com/go-slark/slark/pkg/endpoint"
 	"github.com/go-slark/slark/registry"
 	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"net"
@@ -28,7 +30,7 @@ const (
 )
 
 type Registry struct {
-	clientSet *kubernetes.Clientset
+	clientSet kubernetes.Interface
 	interval  time.Duration
 	token     string
 }
@@ -107,7 +109,7 @@ func (r *Registry) Unregister(ctx context.Context, svc *registry.Service) error
 
 // name(k8s集群中的服务地址) : service-name.namespace.svc.cluster_name:8080
 
-func (r *Registry) Discover(_ context.Context, name string) (registry.Watcher, error) {
+func (r *Registry) Discover(ctx context.Context, name string) (registry.Watcher, error) {
 	str := strings.FieldsFunc(name, func(r rune) bool {
 		return r == ':'
 	})
@@ -136,13 +138,22 @@ func (r *Registry) Discover(_ context.Context, name string) (registry.Watcher, e
 		}))
 	in := inf.Core().V1().Endpoints()
 	notify := make(chan struct{}, 1)
+	// 首次Next在informer同步后立即返回, Endpoints不存在时为空快照
+	notify <- struct{}{}
+	// 非阻塞通知, 避免阻塞informer事件分发; resync产生的重复事件由Tracker去重
+	signal := func() {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
 	_, err = in.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			_, ok := obj.(*coreV1.Endpoints)
 			if !ok {
 				return
 			}
-			notify <- struct{}{}
+			signal()
 		},
 		UpdateFunc: func(oldObj, newObj interface{}) {
 			oEndpoints, ok := oldObj.(*coreV1.Endpoints)
@@ -156,41 +167,72 @@ func (r *Registry) Discover(_ context.Context, name string) (registry.Watcher, e
 			if oEndpoints.ResourceVersion == nEndpoints.ResourceVersion {
 				return
 			}
-			notify <- struct{}{}
+			signal()
 		},
 		DeleteFunc: func(obj interface{}) {
 			_, ok := obj.(*coreV1.Endpoints)
 			if !ok {
 				return
 			}
-			notify <- struct{}{}
+			signal()
 		},
 	})
 	if err != nil {
 		return nil, err
 	}
-	go inf.Start(context.Background().Done())
 	w := &watcher{
-		clientSet: r.clientSet,
-		notify:    notify,
-		ns:        ns,
-		name:      name,
-		port:      port,
+		lister: in.Lister(),
+		synced: in.Informer().HasSynced,
+		notify: notify,
+		ns:     ns,
+		name:   name,
+		port:   port,
 	}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	inf.Start(w.ctx.Done())
 	return w, nil
 }
 
 type watcher struct {
-	clientSet *kubernetes.Clientset
-	ns        string
-	name      string
-	port      int
-	notify    chan struct{}
+	lister  listers.EndpointsLister
+	synced  cache.InformerSynced
+	ctx     context.Context
+	cancel  context.CancelFunc
+	ns      string
+	name    string
+	port    int
+	notify  chan struct{}
+	tracker registry.Tracker
+}
+
+func (w *watcher) Next(ctx context.Context) (*registry.Snapshot, error) {
+	ctx, cancel := registry.Join(ctx, w.ctx)
+	defer cancel()
+	return w.tracker.Next(ctx, w.fetch)
 }
 
 func (w *watcher) List() ([]*registry.Service, error) {
-	<-w.notify
-	endpoints, err := w.clientSet.CoreV1().Endpoints(w.ns).Get(context.Background(), w.name, metaV1.GetOptions{})
+	s, err := w.Next(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Services, nil
+}
+
+func (w *watcher) fetch(ctx context.Context) ([]*registry.Service, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-w.notify:
+	}
+	if !cache.WaitForCacheSync(ctx.Done(), w.synced) {
+		return nil, ctx.Err()
+	}
+	// 从informer本地缓存读取, 不再请求apiserver
+	endpoints, err := w.lister.Endpoints(w.ns).Get(w.name)
+	if apierrors.IsNotFound(err) {
+		return []*registry.Service{}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -229,5 +271,6 @@ func (w *watcher) List() ([]*registry.Service, error) {
 }
 
 func (w *watcher) Stop() error {
+	w.cancel()
 	return nil
 }
@@ -3,7 +3,9 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"k8s.io/client-go/kubernetes/fake"
 	"testing"
+	"time"
 )
 
 func TestK8sDiscovery(t *testing.T) {
@@ -22,3 +24,18 @@ func TestK8sDiscovery(t *testing.T) {
 }
 
 // windows设置host(api server地址) 192.168.xx.xx xxxx.ccs.tencent-cloud.com
+
+func TestDiscoverMissingEndpoints(t *testing.T) {
+	r := &Registry{clientSet: fake.NewSimpleClientset(), interval: time.Minute}
+	w, err := r.Discover(context.TODO(), "user-svc.test.svc.cluster.local:9090")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	s, err := w.Next(ctx)
+	if err != nil || len(s.Services) != 0 {
+		t.Fatalf("unexpected snapshot:%+v %v", s, err)
+	}
+}
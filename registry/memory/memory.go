@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"context"
+	"github.com/go-slark/slark/registry"
+	"sync"
+)
+
+// Registry 进程内注册中心, 用于本地开发及测试
+type Registry struct {
+	l        sync.RWMutex
+	services map[string]map[string]*registry.Service
+	watchers map[string]map[*watcher]struct{}
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		services: make(map[string]map[string]*registry.Service),
+		watchers: make(map[string]map[*watcher]struct{}),
+	}
+}
+
+func (r *Registry) Register(_ context.Context, svc *registry.Service) error {
+	r.l.Lock()
+	set, ok := r.services[svc.Name]
+	if !ok {
+		set = make(map[string]*registry.Service)
+		r.services[svc.Name] = set
+	}
+	set[svc.ID] = clone(svc)
+	r.l.Unlock()
+	r.notify(svc.Name)
+	return nil
+}
+
+func (r *Registry) Unregister(_ context.Context, svc *registry.Service) error {
+	r.l.Lock()
+	set, ok := r.services[svc.Name]
+	if ok {
+		delete(set, svc.ID)
+		if len(set) == 0 {
+			delete(r.services, svc.Name)
+		}
+	}
+	r.l.Unlock()
+	r.notify(svc.Name)
+	return nil
+}
+
+func (r *Registry) Discover(ctx context.Context, name string) (registry.Watcher, error) {
+	w := &watcher{
+		r:      r,
+		name:   name,
+		notify: make(chan struct{}, 1),
+	}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	// 首次Next立即返回
+	w.notify <- struct{}{}
+	r.l.Lock()
+	set, ok := r.watchers[name]
+	if !ok {
+		set = make(map[*watcher]struct{})
+		r.watchers[name] = set
+	}
+	set[w] = struct{}{}
+	r.l.Unlock()
+	return w, nil
+}
+
+func (r *Registry) Service(name string) []*registry.Service {
+	r.l.RLock()
+	defer r.l.RUnlock()
+	svc := make([]*registry.Service, 0, len(r.services[name]))
+	for _, s := range r.services[name] {
+		svc = append(svc, clone(s))
+	}
+	return svc
+}
+
+func (r *Registry) notify(name string) {
+	r.l.RLock()
+	defer r.l.RUnlock()
+	for w := range r.watchers[name] {
+		select {
+		case w.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+type watcher struct {
+	r       *Registry
+	ctx     context.Context
+	cancel  context.CancelFunc
+	name    string
+	notify  chan struct{}
+	tracker registry.Tracker
+}
+
+func (w *watcher) Next(ctx context.Context) (*registry.Snapshot, error) {
+	ctx, cancel := registry.Join(ctx, w.ctx)
+	defer cancel()
+	return w.tracker.Next(ctx, w.fetch)
+}
+
+func (w *watcher) List() ([]*registry.Service, error) {
+	s, err := w.Next(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Services, nil
+}
+
+func (w *watcher) fetch(ctx context.Context) ([]*registry.Service, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-w.notify:
+	}
+	return w.r.Service(w.name), nil
+}
+
+func (w *watcher) Stop() error {
+	w.cancel()
+	w.r.l.Lock()
+	delete(w.r.watchers[w.name], w)
+	w.r.l.Unlock()
+	return nil
+}
+
+func clone(svc *registry.Service) *registry.Service {
+	s := *svc
+	s.Endpoint = append([]string(nil), svc.Endpoint...)
+	if svc.Metadata != nil {
+		s.Metadata = make(map[string]string, len(svc.Metadata))
+		for k, v := range svc.Metadata {
+			s.Metadata[k] = v
+		}
+	}
+	return &s
+}
@@ -0,0 +1,10 @@
+package memory
+
+import (
+	"github.com/go-slark/slark/registry/registrytest"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	registrytest.Run(t, NewRegistry())
+}
@@ -0,0 +1,242 @@
+package nacos
+
+import (
+	"context"
+	"errors"
+	"github.com/go-slark/slark/registry"
+	"github.com/nacos-group/nacos-sdk-go/v2/clients/naming_client"
+	"github.com/nacos-group/nacos-sdk-go/v2/model"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	idKey       = "id"
+	versionKey  = "version"
+	endpointKey = "endpoint"
+	weightKey   = "weight"
+)
+
+// nacos-sdk-go SelectInstances在服务无实例时返回的错误
+const emptyInstances = "instance list is empty!"
+
+type Registry struct {
+	client naming_client.INamingClient
+	opt    *option
+}
+
+type option struct {
+	group   string
+	cluster string
+	weight  float64
+}
+
+type Option func(*option)
+
+func Group(group string) Option {
+	return func(o *option) {
+		o.group = group
+	}
+}
+
+func Cluster(cluster string) Option {
+	return func(o *option) {
+		o.cluster = cluster
+	}
+}
+
+// Weight metadata未设置weight时的默认权重
+func Weight(weight float64) Option {
+	return func(o *option) {
+		o.weight = weight
+	}
+}
+
+func NewRegistry(client naming_client.INamingClient, opts ...Option) *Registry {
+	opt := &option{
+		group:   "DEFAULT_GROUP",
+		cluster: "DEFAULT",
+		weight:  100,
+	}
+	for _, o := range opts {
+		o(opt)
+	}
+	return &Registry{
+		client: client,
+		opt:    opt,
+	}
+}
+
+// Register 以第一个endpoint的地址注册临时实例, 心跳由nacos client维护
+func (r *Registry) Register(_ context.Context, svc *registry.Service) error {
+	ip, port, err := address(svc.Endpoint)
+	if err != nil {
+		return err
+	}
+	md := make(map[string]string, len(svc.Metadata)+3)
+	for k, v := range svc.Metadata {
+		md[k] = v
+	}
+	md[idKey] = svc.ID
+	md[versionKey] = svc.Version
+	md[endpointKey] = strings.Join(svc.Endpoint, ",")
+	weight := r.opt.weight
+	w, ok := svc.Metadata[weightKey]
+	if ok {
+		f, e := strconv.ParseFloat(w, 64)
+		if e == nil && f > 0 {
+			weight = f
+		}
+	}
+	_, err = r.client.RegisterInstance(vo.RegisterInstanceParam{
+		Ip:          ip,
+		Port:        port,
+		Weight:      weight,
+		Enable:      true,
+		Healthy:     true,
+		Metadata:    md,
+		ClusterName: r.opt.cluster,
+		ServiceName: svc.Name,
+		GroupName:   r.opt.group,
+		Ephemeral:   true,
+	})
+	return err
+}
+
+func (r *Registry) Unregister(_ context.Context, svc *registry.Service) error {
+	ip, port, err := address(svc.Endpoint)
+	if err != nil {
+		return err
+	}
+	_, err = r.client.DeregisterInstance(vo.DeregisterInstanceParam{
+		Ip:          ip,
+		Port:        port,
+		Cluster:     r.opt.cluster,
+		ServiceName: svc.Name,
+		GroupName:   r.opt.group,
+		Ephemeral:   true,
+	})
+	return err
+}
+
+func (r *Registry) Discover(ctx context.Context, name string) (registry.Watcher, error) {
+	w := &watcher{
+		client: r.client,
+		notify: make(chan struct{}, 1),
+		first:  true,
+	}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	w.param = &vo.SubscribeParam{
+		ServiceName: name,
+		GroupName:   r.opt.group,
+		Clusters:    []string{r.opt.cluster},
+		SubscribeCallback: func(_ []model.Instance, _ error) {
+			select {
+			case w.notify <- struct{}{}:
+			default:
+			}
+		},
+	}
+	err := r.client.Subscribe(w.param)
+	if err != nil {
+		w.cancel()
+		return nil, err
+	}
+	return w, nil
+}
+
+type watcher struct {
+	client  naming_client.INamingClient
+	ctx     context.Context
+	cancel  context.CancelFunc
+	param   *vo.SubscribeParam
+	notify  chan struct{}
+	first   bool
+	tracker registry.Tracker
+}
+
+func (w *watcher) Next(ctx context.Context) (*registry.Snapshot, error) {
+	ctx, cancel := registry.Join(ctx, w.ctx)
+	defer cancel()
+	return w.tracker.Next(ctx, w.fetch)
+}
+
+func (w *watcher) List() ([]*registry.Service, error) {
+	s, err := w.Next(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Services, nil
+}
+
+func (w *watcher) fetch(ctx context.Context) ([]*registry.Service, error) {
+	if !w.first {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-w.notify:
+		}
+	}
+	instances, err := w.client.SelectInstances(vo.SelectInstancesParam{
+		ServiceName: w.param.ServiceName,
+		GroupName:   w.param.GroupName,
+		Clusters:    w.param.Clusters,
+		HealthyOnly: true,
+	})
+	// 服务无实例时sdk返回error, 视为空列表
+	if err != nil && err.Error() != emptyInstances {
+		return nil, err
+	}
+	w.first = false
+	svc := make([]*registry.Service, 0, len(instances))
+	for _, ins := range instances {
+		md := make(map[string]string, len(ins.Metadata))
+		for k, v := range ins.Metadata {
+			md[k] = v
+		}
+		s := &registry.Service{
+			ID:       md[idKey],
+			Name:     w.param.ServiceName,
+			Version:  md[versionKey],
+			Metadata: md,
+		}
+		if ep := md[endpointKey]; len(ep) > 0 {
+			s.Endpoint = strings.Split(ep, ",")
+		}
+		if _, ok := md[weightKey]; !ok {
+			md[weightKey] = strconv.FormatInt(int64(ins.Weight), 10)
+		}
+		delete(md, idKey)
+		delete(md, versionKey)
+		delete(md, endpointKey)
+		svc = append(svc, s)
+	}
+	return svc, nil
+}
+
+func (w *watcher) Stop() error {
+	w.cancel()
+	return w.client.Unsubscribe(w.param)
+}
+
+func address(endpoint []string) (string, uint64, error) {
+	if len(endpoint) == 0 {
+		return "", 0, errors.New("nacos registry endpoint empty")
+	}
+	u, err := url.Parse(endpoint[0])
+	if err != nil {
+		return "", 0, err
+	}
+	host, p, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		return "", 0, err
+	}
+	port, err := strconv.ParseUint(p, 10, 64)
+	if err != nil {
+		return "", 0, err
+	}
+	return host, port, nil
+}
@@ -0,0 +1,83 @@
+package nacos
+
+import (
+	"errors"
+	"github.com/go-slark/slark/registry/registrytest"
+	"github.com/nacos-group/nacos-sdk-go/v2/clients/naming_client"
+	"github.com/nacos-group/nacos-sdk-go/v2/model"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// naming nacos naming client的内存实现
+type naming struct {
+	naming_client.INamingClient
+	l         sync.Mutex
+	instances map[string]model.Instance
+	callbacks []func([]model.Instance, error)
+}
+
+func (n *naming) RegisterInstance(param vo.RegisterInstanceParam) (bool, error) {
+	n.change(func() {
+		n.instances[param.Ip+":"+strconv.FormatUint(param.Port, 10)] = model.Instance{
+			Ip:          param.Ip,
+			Port:        param.Port,
+			Weight:      param.Weight,
+			Healthy:     param.Healthy,
+			Enable:      param.Enable,
+			ServiceName: param.ServiceName,
+			Metadata:    param.Metadata,
+		}
+	})
+	return true, nil
+}
+
+func (n *naming) DeregisterInstance(param vo.DeregisterInstanceParam) (bool, error) {
+	n.change(func() {
+		delete(n.instances, param.Ip+":"+strconv.FormatUint(param.Port, 10))
+	})
+	return true, nil
+}
+
+func (n *naming) SelectInstances(param vo.SelectInstancesParam) ([]model.Instance, error) {
+	n.l.Lock()
+	defer n.l.Unlock()
+	instances := make([]model.Instance, 0, len(n.instances))
+	for _, ins := range n.instances {
+		if ins.ServiceName == param.ServiceName {
+			instances = append(instances, ins)
+		}
+	}
+	// 与sdk一致, 无实例时返回error
+	if len(instances) == 0 {
+		return instances, errors.New(emptyInstances)
+	}
+	return instances, nil
+}
+
+func (n *naming) Subscribe(param *vo.SubscribeParam) error {
+	n.l.Lock()
+	n.callbacks = append(n.callbacks, param.SubscribeCallback)
+	n.l.Unlock()
+	return nil
+}
+
+func (n *naming) Unsubscribe(_ *vo.SubscribeParam) error {
+	return nil
+}
+
+func (n *naming) change(fn func()) {
+	n.l.Lock()
+	fn()
+	callbacks := n.callbacks
+	n.l.Unlock()
+	for _, cb := range callbacks {
+		cb(nil, nil)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registrytest.Run(t, NewRegistry(&naming{instances: map[string]model.Instance{}}))
+}
@@ -20,6 +20,9 @@ type Discovery interface {
 }
 
 type Watcher interface {
+	// Next 首次调用立即返回当前快照, 之后阻塞直到服务列表发生变化或ctx结束
+	Next(ctx context.Context) (*Snapshot, error)
+	// List 等价于Next, 只返回全量列表
 	List() ([]*Service, error)
 	Stop() error
 }
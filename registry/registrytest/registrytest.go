@@ -0,0 +1,103 @@
+// Package registrytest 注册中心后端的通用测试, 各后端提供fixture后调用Run
+package registrytest
+
+import (
+	"context"
+	utils "github.com/go-slark/slark/pkg"
+	"github.com/go-slark/slark/registry"
+	"sort"
+	"testing"
+	"time"
+)
+
+type Backend interface {
+	registry.Registry
+	registry.Discovery
+}
+
+// Run 依次注册/注销服务, 校验watcher返回的全量列表
+func Run(t *testing.T, r Backend) {
+	t.Helper()
+	ctx := context.Background()
+	w, err := r.Discover(ctx, "user-svc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	next := func() []*registry.Service {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+		defer cancel()
+		s, e := w.Next(ctx)
+		if e != nil {
+			t.Fatalf("watcher next: %v", e)
+		}
+		return s.Services
+	}
+	if svc := next(); len(svc) != 0 {
+		t.Fatalf("unexpected initial list:%v", svc)
+	}
+
+	a := &registry.Service{
+		ID:       "1",
+		Name:     "user-svc",
+		Version:  "v1.0.0",
+		Endpoint: []string{"grpc://127.0.0.1:9090", "http://127.0.0.1:8080"},
+		Metadata: map[string]string{utils.Weight: "50", "region": "sh"},
+	}
+	b := &registry.Service{
+		ID:       "2",
+		Name:     "user-svc",
+		Version:  "v1.0.0",
+		Endpoint: []string{"grpc://127.0.0.2:9090", "http://127.0.0.2:8080"},
+		Metadata: map[string]string{utils.Weight: "100", "region": "bj"},
+	}
+	cases := []struct {
+		name string
+		op   func() error
+		want []*registry.Service
+	}{
+		{"register", func() error { return r.Register(ctx, a) }, []*registry.Service{a}},
+		{"register another", func() error { return r.Register(ctx, b) }, []*registry.Service{a, b}},
+		{"unregister", func() error { return r.Unregister(ctx, a) }, []*registry.Service{b}},
+		{"unregister all", func() error { return r.Unregister(ctx, b) }, nil},
+	}
+	for _, c := range cases {
+		if err = c.op(); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		got := next()
+		if len(got) != len(c.want) {
+			t.Fatalf("%s: unexpected list:%v", c.name, got)
+		}
+		sort.Slice(got, func(i, j int) bool {
+			return got[i].ID < got[j].ID
+		})
+		for i, want := range c.want {
+			if !equal(got[i], want) {
+				t.Fatalf("%s: expect %+v, got %+v", c.name, want, got[i])
+			}
+		}
+	}
+}
+
+func equal(got, want *registry.Service) bool {
+	if got.ID != want.ID || got.Version != want.Version || len(got.Endpoint) != len(want.Endpoint) {
+		return false
+	}
+	endpoints := map[string]struct{}{}
+	for _, e := range got.Endpoint {
+		endpoints[e] = struct{}{}
+	}
+	for _, e := range want.Endpoint {
+		if _, ok := endpoints[e]; !ok {
+			return false
+		}
+	}
+	for k, v := range want.Metadata {
+		if got.Metadata[k] != v {
+			return false
+		}
+	}
+	return true
+}
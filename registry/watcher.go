@@ -0,0 +1,111 @@
+package registry
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// Snapshot 服务列表快照, Version在列表变化时递增
+type Snapshot struct {
+	Version  uint64
+	Services []*Service
+	Added    []*Service
+	Removed  []*Service
+}
+
+// Tracker 对比前后两次全量列表, 计算版本号及增删, 各注册中心的watcher共用
+type Tracker struct {
+	version uint64
+	last    map[string]*Service
+}
+
+// Track 返回快照及列表是否发生变化, 首次调用视为变化
+func (t *Tracker) Track(svc []*Service) (*Snapshot, bool) {
+	current := make(map[string]*Service, len(svc))
+	for _, s := range svc {
+		current[key(s)] = s
+	}
+	first := t.last == nil
+	s := &Snapshot{Services: svc}
+	for k, v := range current {
+		old, ok := t.last[k]
+		if !ok {
+			s.Added = append(s.Added, v)
+			continue
+		}
+		if !equal(old, v) {
+			s.Removed = append(s.Removed, old)
+			s.Added = append(s.Added, v)
+		}
+	}
+	for k, v := range t.last {
+		if _, ok := current[k]; !ok {
+			s.Removed = append(s.Removed, v)
+		}
+	}
+	changed := first || len(s.Added) > 0 || len(s.Removed) > 0
+	if changed {
+		t.version++
+		t.last = current
+	}
+	s.Version = t.version
+	return s, changed
+}
+
+// Next 循环调用fetch直到列表发生变化, fetch需在ctx结束时返回
+func (t *Tracker) Next(ctx context.Context, fetch func(ctx context.Context) ([]*Service, error)) (*Snapshot, error) {
+	for {
+		svc, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := t.Track(svc)
+		if ok {
+			return s, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Join 返回的ctx在ctx或stop任一结束时结束, 用于合并调用方ctx与watcher.Stop
+func Join(ctx, stop context.Context) (context.Context, context.CancelFunc) {
+	cx, cancel := context.WithCancel(ctx)
+	unregister := context.AfterFunc(stop, cancel)
+	return cx, func() {
+		unregister()
+		cancel()
+	}
+}
+
+func key(s *Service) string {
+	if len(s.ID) > 0 {
+		return s.ID
+	}
+	ep := append([]string(nil), s.Endpoint...)
+	sort.Strings(ep)
+	return strings.Join(ep, ",")
+}
+
+func equal(a, b *Service) bool {
+	if a.Name != b.Name || a.Version != b.Version || len(a.Endpoint) != len(b.Endpoint) || len(a.Metadata) != len(b.Metadata) {
+		return false
+	}
+	ae := append([]string(nil), a.Endpoint...)
+	be := append([]string(nil), b.Endpoint...)
+	sort.Strings(ae)
+	sort.Strings(be)
+	for i := range ae {
+		if ae[i] != be[i] {
+			return false
+		}
+	}
+	for k, v := range a.Metadata {
+		if bv, ok := b.Metadata[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestTracker(t *testing.T) {
+	var tr Tracker
+	a := &Service{ID: "a", Name: "svc", Endpoint: []string{"grpc://127.0.0.1:1"}}
+	b := &Service{ID: "b", Name: "svc", Endpoint: []string{"grpc://127.0.0.1:2"}}
+
+	s, ok := tr.Track(nil)
+	if !ok || s.Version != 1 {
+		t.Fatalf("first track: %v %+v", ok, s)
+	}
+	s, ok = tr.Track([]*Service{a, b})
+	if !ok || s.Version != 2 || len(s.Added) != 2 || len(s.Removed) != 0 {
+		t.Fatalf("add: %v %+v", ok, s)
+	}
+	_, ok = tr.Track([]*Service{b, a})
+	if ok {
+		t.Fatal("unchanged list reported as change")
+	}
+	a2 := &Service{ID: "a", Name: "svc", Version: "v2", Endpoint: []string{"grpc://127.0.0.1:1"}}
+	s, ok = tr.Track([]*Service{a2})
+	if !ok || s.Version != 3 || len(s.Added) != 1 || len(s.Removed) != 2 {
+		t.Fatalf("update: %v %+v", ok, s)
+	}
+}
+
+func TestTrackerNext(t *testing.T) {
+	var tr Tracker
+	svc := []*Service{{ID: "a", Name: "svc"}}
+	calls := 0
+	fetch := func(ctx context.Context) ([]*Service, error) {
+		calls++
+		if calls > 3 {
+			return nil, errors.New("closed")
+		}
+		return svc, nil
+	}
+	s, err := tr.Next(context.Background(), fetch)
+	if err != nil || s.Version != 1 {
+		t.Fatalf("next: %v %+v", err, s)
+	}
+	// 列表未变化时继续等待, 直到fetch返回错误
+	_, err = tr.Next(context.Background(), fetch)
+	if err == nil || calls != 4 {
+		t.Fatalf("expected error after unchanged fetches, calls=%d err=%v", calls, err)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	stop, cancel := context.WithCancel(context.Background())
+	ctx, release := Join(context.Background(), stop)
+	defer release()
+	cancel()
+	<-ctx.Done()
+}
@@ -0,0 +1,210 @@
+package zookeeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-slark/slark/registry"
+	"github.com/go-zookeeper/zk"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Conn *zk.Conn实现了该接口
+type Conn interface {
+	Create(path string, data []byte, flags int32, acl []zk.ACL) (string, error)
+	Delete(path string, version int32) error
+	ExistsW(path string) (bool, *zk.Stat, <-chan zk.Event, error)
+	Get(path string) ([]byte, *zk.Stat, error)
+	ChildrenW(path string) ([]string, *zk.Stat, <-chan zk.Event, error)
+}
+
+type Registry struct {
+	conn   Conn
+	ns     string
+	l      sync.Mutex
+	cancel map[string]context.CancelFunc
+}
+
+type Option func(*Registry)
+
+func Namespace(ns string) Option {
+	return func(r *Registry) {
+		r.ns = ns
+	}
+}
+
+func NewRegistry(conn Conn, opts ...Option) *Registry {
+	r := &Registry{
+		conn:   conn,
+		ns:     "/slark",
+		cancel: make(map[string]context.CancelFunc),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Register 服务注册为临时节点, session过期节点被删除后自动重建
+func (r *Registry) Register(_ context.Context, svc *registry.Service) error {
+	dir := path.Join(r.ns, svc.Name)
+	err := r.ensure(dir)
+	if err != nil {
+		return err
+	}
+	value, err := json.Marshal(svc)
+	if err != nil {
+		return err
+	}
+	node := path.Join(dir, svc.ID)
+	_, err = r.conn.Create(node, value, zk.FlagEphemeral, zk.WorldACL(zk.PermAll))
+	if err != nil && !errors.Is(err, zk.ErrNodeExists) {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.l.Lock()
+	if c, ok := r.cancel[node]; ok {
+		c()
+	}
+	r.cancel[node] = cancel
+	r.l.Unlock()
+	go r.keep(ctx, node, value)
+	return nil
+}
+
+func (r *Registry) keep(ctx context.Context, node string, value []byte) {
+	for {
+		exist, _, ch, err := r.conn.ExistsW(node)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		if !exist {
+			_, err = r.conn.Create(node, value, zk.FlagEphemeral, zk.WorldACL(zk.PermAll))
+			if err != nil && !errors.Is(err, zk.ErrNodeExists) {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Second):
+				}
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+		}
+	}
+}
+
+func (r *Registry) ensure(dir string) error {
+	var p string
+	for _, seg := range strings.Split(strings.Trim(dir, "/"), "/") {
+		p += "/" + seg
+		_, err := r.conn.Create(p, nil, 0, zk.WorldACL(zk.PermAll))
+		if err != nil && !errors.Is(err, zk.ErrNodeExists) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Registry) Unregister(_ context.Context, svc *registry.Service) error {
+	node := path.Join(r.ns, svc.Name, svc.ID)
+	r.l.Lock()
+	if cancel, ok := r.cancel[node]; ok {
+		cancel()
+		delete(r.cancel, node)
+	}
+	r.l.Unlock()
+	err := r.conn.Delete(node, -1)
+	if errors.Is(err, zk.ErrNoNode) {
+		return nil
+	}
+	return err
+}
+
+func (r *Registry) Discover(ctx context.Context, name string) (registry.Watcher, error) {
+	dir := path.Join(r.ns, name)
+	err := r.ensure(dir)
+	if err != nil {
+		return nil, err
+	}
+	w := &watcher{
+		conn: r.conn,
+		dir:  dir,
+	}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	return w, nil
+}
+
+type watcher struct {
+	conn    Conn
+	ctx     context.Context
+	cancel  context.CancelFunc
+	dir     string
+	event   <-chan zk.Event
+	tracker registry.Tracker
+}
+
+func (w *watcher) Next(ctx context.Context) (*registry.Snapshot, error) {
+	ctx, cancel := registry.Join(ctx, w.ctx)
+	defer cancel()
+	return w.tracker.Next(ctx, w.fetch)
+}
+
+func (w *watcher) List() ([]*registry.Service, error) {
+	s, err := w.Next(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Services, nil
+}
+
+// fetch 首次调用立即返回, 之后等待子节点变化
+func (w *watcher) fetch(ctx context.Context) ([]*registry.Service, error) {
+	if w.event != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-w.event:
+		}
+	}
+	children, _, event, err := w.conn.ChildrenW(w.dir)
+	if err != nil {
+		w.event = nil
+		return nil, err
+	}
+	w.event = event
+	svc := make([]*registry.Service, 0, len(children))
+	for _, child := range children {
+		value, _, e := w.conn.Get(path.Join(w.dir, child))
+		if e != nil {
+			if errors.Is(e, zk.ErrNoNode) {
+				continue
+			}
+			return nil, e
+		}
+		s := &registry.Service{}
+		e = json.Unmarshal(value, s)
+		if e != nil {
+			return nil, e
+		}
+		svc = append(svc, s)
+	}
+	return svc, nil
+}
+
+func (w *watcher) Stop() error {
+	w.cancel()
+	return nil
+}
@@ -0,0 +1,96 @@
+package zookeeper
+
+import (
+	"github.com/go-slark/slark/registry/registrytest"
+	"github.com/go-zookeeper/zk"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// tree zookeeper节点树的内存实现
+type tree struct {
+	l        sync.Mutex
+	nodes    map[string][]byte
+	children map[string][]chan zk.Event
+	exists   map[string][]chan zk.Event
+}
+
+func newTree() *tree {
+	return &tree{
+		nodes:    map[string][]byte{},
+		children: map[string][]chan zk.Event{},
+		exists:   map[string][]chan zk.Event{},
+	}
+}
+
+func (t *tree) Create(p string, data []byte, _ int32, _ []zk.ACL) (string, error) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	if _, ok := t.nodes[p]; ok {
+		return "", zk.ErrNodeExists
+	}
+	t.nodes[p] = data
+	t.fire(p)
+	return p, nil
+}
+
+func (t *tree) Delete(p string, _ int32) error {
+	t.l.Lock()
+	defer t.l.Unlock()
+	if _, ok := t.nodes[p]; !ok {
+		return zk.ErrNoNode
+	}
+	delete(t.nodes, p)
+	t.fire(p)
+	return nil
+}
+
+func (t *tree) fire(p string) {
+	for _, ch := range t.children[path.Dir(p)] {
+		ch <- zk.Event{Type: zk.EventNodeChildrenChanged}
+	}
+	delete(t.children, path.Dir(p))
+	for _, ch := range t.exists[p] {
+		ch <- zk.Event{Type: zk.EventNodeDeleted}
+	}
+	delete(t.exists, p)
+}
+
+func (t *tree) ExistsW(p string) (bool, *zk.Stat, <-chan zk.Event, error) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	ch := make(chan zk.Event, 1)
+	t.exists[p] = append(t.exists[p], ch)
+	_, ok := t.nodes[p]
+	return ok, &zk.Stat{}, ch, nil
+}
+
+func (t *tree) Get(p string) ([]byte, *zk.Stat, error) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	v, ok := t.nodes[p]
+	if !ok {
+		return nil, nil, zk.ErrNoNode
+	}
+	return v, &zk.Stat{}, nil
+}
+
+func (t *tree) ChildrenW(p string) ([]string, *zk.Stat, <-chan zk.Event, error) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	var children []string
+	for k := range t.nodes {
+		if path.Dir(k) == p && strings.HasPrefix(k, p) {
+			children = append(children, path.Base(k))
+		}
+	}
+	ch := make(chan zk.Event, 1)
+	t.children[p] = append(t.children[p], ch)
+	return children, &zk.Stat{}, ch, nil
+}
+
+func TestRegistry(t *testing.T) {
+	registrytest.Run(t, NewRegistry(newTree()))
+}
@@ -0,0 +1,8 @@
+package swagger_ui
+
+import "embed"
+
+// Dist swagger ui静态文件, swagger-initializer.js由transport/http按文档列表生成
+//
+//go:embed dist/index.html dist/index.css dist/swagger-ui.css dist/swagger-ui-bundle.js dist/swagger-ui-standalone-preset.js dist/oauth2-redirect.html dist/favicon-16x16.png dist/favicon-32x32.png
+var Dist embed.FS
@@ -1,3 +1,5 @@
-//go:generate statik -src=dist -f
-
+// Package swagger_ui 内嵌的swagger-ui v5.18.2 dist静态文件, 由transport/http/openapi/swaggerui引用
+// 升级时修改下面的版本号后执行go generate, 再检查embed.go中列出的文件是否仍然存在
 package swagger_ui
+
+//go:generate sh -c "curl -fsSL https://github.com/swagger-api/swagger-ui/archive/refs/tags/v5.18.2.tar.gz | tar -xz --strip-components=1 swagger-ui-5.18.2/dist"
@@ -0,0 +1,54 @@
+package algo
+
+import (
+	"context"
+	"errors"
+	"github.com/go-slark/slark/transport/grpc/balancer/node"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+const forcePick = 3 * time.Second
+
+// p2c power of two choices: 随机选取两个节点, 选择权重较高者
+type p2c struct {
+	l sync.Mutex
+	r *rand.Rand
+}
+
+func NewP2CBuilder() node.Builder {
+	return node.BuilderFunc(func() node.Balancer {
+		b := &node.BalancerBuilder{
+			Picker:          &p2c{r: rand.New(rand.NewSource(time.Now().UnixNano()))},
+			WeightedBuilder: node.NewEWMA(),
+		}
+		return b.Build()
+	})
+}
+
+func (p *p2c) Pick(_ context.Context, nodes []node.WeightedNode) (node.WeightedNode, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("no available node")
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	p.l.Lock()
+	a := p.r.Intn(len(nodes))
+	b := p.r.Intn(len(nodes) - 1)
+	p.l.Unlock()
+	if b >= a {
+		b++
+	}
+	pc, upc := nodes[a], nodes[b]
+	if pc.Weight() < upc.Weight() {
+		pc, upc = upc, pc
+	}
+	// 长时间未被选中的节点强制选中一次, 以更新其统计信息
+	fb, ok := upc.(node.Feedback)
+	if ok && fb.PickElapsed() > forcePick {
+		pc = upc
+	}
+	return pc, nil
+}
@@ -0,0 +1,62 @@
+package algo
+
+import (
+	"context"
+	"github.com/go-slark/slark/errors"
+	"github.com/go-slark/slark/transport/grpc/balancer/node"
+	"testing"
+	"time"
+)
+
+func TestP2C(t *testing.T) {
+	b := NewP2CBuilder().Build()
+	b.Save([]node.Node{
+		&node.WrappedNode{Addr: "127.0.0.1:9001"},
+		&node.WrappedNode{Addr: "127.0.0.1:9002"},
+		&node.WrappedNode{Addr: "127.0.0.1:9003"},
+	})
+	hits := map[string]int{}
+	for i := 0; i < 3000; i++ {
+		n, err := b.Pick(context.TODO())
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr := n.Address()
+		hits[addr]++
+		done := n.(node.Feedback).Pick()
+		var e error
+		switch addr {
+		case "127.0.0.1:9002":
+			time.Sleep(200 * time.Microsecond)
+		case "127.0.0.1:9003":
+			e = errors.ServerUnavailable("unavailable", "UNAVAILABLE")
+		}
+		done(context.TODO(), node.DoneInfo{Err: e})
+	}
+	if hits["127.0.0.1:9001"] <= hits["127.0.0.1:9002"] || hits["127.0.0.1:9001"] <= hits["127.0.0.1:9003"] {
+		t.Fatalf("slow or failing node not shed:%v", hits)
+	}
+}
+
+func TestEWMAIsolation(t *testing.T) {
+	nodes := []node.Node{&node.WrappedNode{Addr: "127.0.0.1:9001"}}
+	builder := NewP2CBuilder()
+	a, b := builder.Build(), builder.Build()
+	a.Save(nodes)
+	b.Save(nodes)
+	na, _ := a.Pick(context.TODO())
+	nb, _ := b.Pick(context.TODO())
+	fresh := nb.(node.WeightedNode).Weight()
+	na.(node.Feedback).Pick()(context.TODO(), node.DoneInfo{Err: errors.ServerUnavailable("unavailable", "UNAVAILABLE")})
+	if nb.(node.WeightedNode).Weight() != fresh || na.(node.WeightedNode).Weight() == fresh {
+		t.Fatal("ewma stats shared between balancers")
+	}
+
+	// 节点下线后统计释放, 重新上线按新节点计算
+	a.Save([]node.Node{&node.WrappedNode{Addr: "127.0.0.1:9002"}})
+	a.Save(nodes)
+	na, _ = a.Pick(context.TODO())
+	if na.(node.WeightedNode).Weight() != fresh {
+		t.Fatal("ewma stats not released after node left")
+	}
+}
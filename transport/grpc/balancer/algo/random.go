@@ -13,7 +13,10 @@ type random struct {
 }
 
 func NewRandomBuilder() node.Builder {
-	return &node.BalancerBuilder{Picker: &random{r: rand.New(rand.NewSource(time.Now().UnixNano()))}}
+	return &node.BalancerBuilder{
+		Picker:          &random{r: rand.New(rand.NewSource(time.Now().UnixNano()))},
+		WeightedBuilder: &node.Plain{},
+	}
 }
 
 func (r *random) Pick(_ context.Context, nodes []node.WeightedNode) (node.WeightedNode, error) {
@@ -13,10 +13,13 @@ type wrr struct {
 }
 
 func NewWRRBuilder() node.Builder {
-	return &node.BalancerBuilder{
-		Picker:          &wrr{weight: map[string]int64{}},
-		WeightedBuilder: &node.Plain{},
-	}
+	return node.BuilderFunc(func() node.Balancer {
+		b := &node.BalancerBuilder{
+			Picker:          &wrr{weight: map[string]int64{}},
+			WeightedBuilder: &node.Plain{},
+		}
+		return b.Build()
+	})
 }
 
 func (w *wrr) Pick(_ context.Context, nodes []node.WeightedNode) (node.WeightedNode, error) {
@@ -44,3 +47,18 @@ func (w *wrr) Pick(_ context.Context, nodes []node.WeightedNode) (node.WeightedN
 	w.l.Unlock()
 	return hn, nil
 }
+
+// Retain 删除已下线节点的当前权重
+func (w *wrr) Retain(nodes []node.Node) {
+	alive := make(map[string]struct{}, len(nodes))
+	for _, n := range nodes {
+		alive[n.Address()] = struct{}{}
+	}
+	w.l.Lock()
+	for addr := range w.weight {
+		if _, ok := alive[addr]; !ok {
+			delete(w.weight, addr)
+		}
+	}
+	w.l.Unlock()
+}
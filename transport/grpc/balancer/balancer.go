@@ -12,26 +12,42 @@ import (
 	"strconv"
 )
 
-const LoadBalancer = "load_balancer"
+const (
+	LoadBalancer = "load_balancer"
+	P2C          = "p2c"
+)
 
-var pickerBuilder = &builder{Builder: algo.NewWRRBuilder()}
+var loadBalancer = &balancerBuilder{name: LoadBalancer, Builder: algo.NewWRRBuilder()}
 
 func SetBuilder(builder node.Builder) {
-	pickerBuilder.Builder = builder
+	loadBalancer.Builder = builder
 }
 
 func init() {
-	balancer.Register(base.NewBalancerBuilder(
-		LoadBalancer,
-		pickerBuilder,
-		base.Config{HealthCheck: true},
-	))
+	balancer.Register(loadBalancer)
+	// loadBalancingConfig: [ {"p2c": {} } ]
+	balancer.Register(&balancerBuilder{name: P2C, Builder: algo.NewP2CBuilder()})
 }
 
-type builder struct {
+// balancerBuilder 每个ClientConn独立的node.Balancer, picker重建时节点统计不丢失
+type balancerBuilder struct {
+	name string
 	node.Builder
 }
 
+func (b *balancerBuilder) Name() string {
+	return b.name
+}
+
+func (b *balancerBuilder) Build(cc balancer.ClientConn, opts balancer.BuildOptions) balancer.Balancer {
+	pb := &builder{Balancer: b.Builder.Build()}
+	return base.NewBalancerBuilder(b.name, pb, base.Config{HealthCheck: true}).Build(cc, opts)
+}
+
+type builder struct {
+	node.Balancer
+}
+
 func (b *builder) Build(info base.PickerBuildInfo) balancer.Picker {
 	if len(info.ReadySCs) == 0 {
 		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
@@ -54,11 +70,10 @@ func (b *builder) Build(info base.PickerBuildInfo) balancer.Picker {
 		}
 		nodes = append(nodes, n)
 	}
-	p := &picker{
-		Balancer: b.Builder.Build(),
+	b.Save(nodes)
+	return &picker{
+		Balancer: b.Balancer,
 	}
-	p.Save(nodes)
-	return p
 }
 
 type picker struct {
@@ -78,9 +93,27 @@ func (p *picker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
 	if err != nil {
 		return balancer.PickResult{}, err
 	}
+	wn := node.Unwrap(n)
+	if wn == nil {
+		return balancer.PickResult{}, balancer.ErrNoSubConnAvailable
+	}
+	var done node.DoneFunc
+	fb, ok := n.(node.Feedback)
+	if ok {
+		done = fb.Pick()
+	}
 	result := balancer.PickResult{
-		SubConn: n.(*node.WrappedNode).SubConn,
-		Done:    func(info balancer.DoneInfo) {},
+		SubConn: wn.SubConn,
+		Done: func(di balancer.DoneInfo) {
+			if done == nil {
+				return
+			}
+			done(info.Ctx, node.DoneInfo{
+				Err:           di.Err,
+				BytesSent:     di.BytesSent,
+				BytesReceived: di.BytesReceived,
+			})
+		},
 	}
 	return result, nil
 }
@@ -0,0 +1,81 @@
+package balancer
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-slark/slark/registry"
+	"github.com/go-slark/slark/registry/memory"
+	"github.com/go-slark/slark/transport/grpc"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"testing"
+	"time"
+)
+
+func TestDiscoveryBalancer(t *testing.T) {
+	r := memory.NewRegistry()
+	services := make([]*registry.Service, 0, 2)
+	for i := 0; i < 2; i++ {
+		srv := grpc.NewServer(grpc.Address("127.0.0.1:0"))
+		go func() {
+			_ = srv.Start()
+		}()
+		defer srv.Stop(context.TODO())
+		<-srv.Ready()
+		u, _ := srv.Endpoint()
+		svc := &registry.Service{
+			ID:       fmt.Sprint(i),
+			Name:     "user-svc",
+			Endpoint: []string{"grpc://127.0.0.1:" + u.Port()},
+		}
+		_ = r.Register(context.TODO(), svc)
+		services = append(services, svc)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.Dial(ctx, grpc.WithAddr("discovery:///user-svc"), grpc.WithDiscovery(r), grpc.WithStrategy([]grpc.Strategy{
+		{Name: `"loadBalancingConfig"`, Value: `[ {"p2c": {} } ]`},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := grpc_health_v1.NewHealthClient(conn)
+	hits := func(n int) map[string]int {
+		mp := map[string]int{}
+		for i := 0; i < n; i++ {
+			p := &peer.Peer{}
+			_, e := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{}, ggrpc.WaitForReady(true), ggrpc.Peer(p))
+			if e != nil {
+				t.Fatal(e)
+			}
+			mp[p.Addr.String()]++
+		}
+		return mp
+	}
+
+	// 两个节点ready后均应被选中
+	deadline := time.Now().Add(3 * time.Second)
+	for len(hits(50)) != 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("p2c balancer not spreading requests across nodes")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// 节点注销后只请求剩余节点
+	_ = r.Unregister(context.TODO(), services[0])
+	alive := services[1].Endpoint[0][len("grpc://"):]
+	for {
+		mp := hits(20)
+		if len(mp) == 1 && mp[alive] == 20 {
+			break
+		}
+		if time.Now().After(deadline.Add(2 * time.Second)) {
+			t.Fatalf("unregistered node still picked:%v", mp)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
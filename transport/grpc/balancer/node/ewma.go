@@ -0,0 +1,132 @@
+package node
+
+import (
+	"context"
+	"github.com/go-slark/slark/errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	tau     = int64(600 * time.Millisecond) // 时延衰减时间窗口
+	penalty = int64(250 * time.Millisecond) // 无时延统计时的初始时延
+)
+
+type stat struct {
+	lag      int64  // ewma latency(ns)
+	success  uint64 // ewma success rate(0~1000)
+	inflight int64
+	stamp    int64 // last done time
+	pick     int64 // last pick time
+}
+
+// EWMAWeight 根据ewma时延, 成功率及请求中数量计算动态权重
+type EWMAWeight struct {
+	Node
+	*stat
+}
+
+func (w *EWMAWeight) Weight() int64 {
+	weight := float64(100)
+	if iw := w.InitialWeight(); iw != nil && *iw > 0 {
+		weight = float64(*iw)
+	}
+	lag := atomic.LoadInt64(&w.lag)
+	if lag <= 0 {
+		lag = penalty
+	}
+	success := float64(atomic.LoadUint64(&w.success)) / 1000
+	inflight := float64(atomic.LoadInt64(&w.inflight) + 1)
+	return int64(weight*success*1e6/(math.Sqrt(float64(lag)+1)*inflight)) + 1
+}
+
+func (w *EWMAWeight) Unwrap() Node {
+	return w.Node
+}
+
+func (w *EWMAWeight) PickElapsed() time.Duration {
+	return time.Duration(time.Now().UnixNano() - atomic.LoadInt64(&w.pick))
+}
+
+func (w *EWMAWeight) Pick() DoneFunc {
+	start := time.Now().UnixNano()
+	atomic.StoreInt64(&w.pick, start)
+	atomic.AddInt64(&w.inflight, 1)
+	return func(_ context.Context, di DoneInfo) {
+		atomic.AddInt64(&w.inflight, -1)
+		now := time.Now().UnixNano()
+		stamp := atomic.SwapInt64(&w.stamp, now)
+		td := now - stamp
+		if td < 0 {
+			td = 0
+		}
+		beta := math.Exp(float64(-td) / float64(tau))
+		lag := now - start
+		if lag < 0 {
+			lag = 0
+		}
+		old := atomic.LoadInt64(&w.lag)
+		if old == 0 {
+			beta = 0
+		}
+		atomic.StoreInt64(&w.lag, int64(float64(old)*beta+float64(lag)*(1-beta)))
+
+		success := uint64(1000)
+		if failure(di.Err) {
+			success = 0
+		}
+		atomic.StoreUint64(&w.success, uint64(float64(atomic.LoadUint64(&w.success))*beta+float64(success)*(1-beta)))
+	}
+}
+
+// failure 只统计服务端异常, 业务错误不影响成功率
+func failure(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.IsServerUnavailable(err) || errors.IsInternalServer(err) || errors.IsServerTimeout(err) || errors.IsServerRateLimit(err)
+}
+
+// EWMA 按地址保存统计信息, 节点列表更新后统计不丢失, 每个balancer独立一份
+type EWMA struct {
+	l     sync.Mutex
+	stats map[string]*stat
+}
+
+func NewEWMA() *EWMA {
+	return &EWMA{stats: make(map[string]*stat)}
+}
+
+func (e *EWMA) Build(node Node) WeightedNode {
+	e.l.Lock()
+	s, ok := e.stats[node.Address()]
+	if !ok {
+		s = &stat{
+			success: 1000,
+			stamp:   time.Now().UnixNano(),
+		}
+		e.stats[node.Address()] = s
+	}
+	e.l.Unlock()
+	return &EWMAWeight{
+		Node: node,
+		stat: s,
+	}
+}
+
+// Retain 删除已下线节点的统计信息
+func (e *EWMA) Retain(nodes []Node) {
+	alive := make(map[string]struct{}, len(nodes))
+	for _, n := range nodes {
+		alive[n.Address()] = struct{}{}
+	}
+	e.l.Lock()
+	for addr := range e.stats {
+		if _, ok := alive[addr]; !ok {
+			delete(e.stats, addr)
+		}
+	}
+	e.l.Unlock()
+}
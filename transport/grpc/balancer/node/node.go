@@ -5,6 +5,7 @@ import (
 	"errors"
 	"google.golang.org/grpc/balancer"
 	"sync"
+	"time"
 )
 
 type WrappedNode struct {
@@ -32,6 +33,34 @@ type WeightedNode interface {
 	//Unwrap() Node
 }
 
+type DoneInfo struct {
+	Err           error
+	BytesSent     bool
+	BytesReceived bool
+}
+
+type DoneFunc func(ctx context.Context, di DoneInfo)
+
+// Feedback 节点被选中时调用Pick, 请求结束后通过DoneFunc回传结果
+type Feedback interface {
+	Pick() DoneFunc
+	PickElapsed() time.Duration
+}
+
+// Unwrap 获取最内层的WrappedNode
+func Unwrap(n Node) *WrappedNode {
+	for {
+		switch v := n.(type) {
+		case *WrappedNode:
+			return v
+		case interface{ Unwrap() Node }:
+			n = v.Unwrap()
+		default:
+			return nil
+		}
+	}
+}
+
 type Set struct {
 	nodes   []WeightedNode
 	l       sync.RWMutex
@@ -47,6 +76,18 @@ type Builder interface {
 	Build() Balancer
 }
 
+// BuilderFunc 每次Build创建独立的Picker / WeightedBuilder, 节点状态不在balancer间共享
+type BuilderFunc func() Balancer
+
+func (f BuilderFunc) Build() Balancer {
+	return f()
+}
+
+// Retainer 可选, 由Picker / WeightedBuilder实现, 节点列表更新时释放已下线节点的状态
+type Retainer interface {
+	Retain(nodes []Node)
+}
+
 type Filter func(ctx context.Context, nodes []Node) []Node
 
 type Balancer interface {
@@ -62,6 +103,12 @@ func (s *Set) Save(nodes []Node) {
 	s.l.Lock()
 	s.nodes = wn
 	s.l.Unlock()
+	if r, ok := s.builder.(Retainer); ok {
+		r.Retain(nodes)
+	}
+	if r, ok := s.picker.(Retainer); ok {
+		r.Retain(nodes)
+	}
 }
 
 func (s *Set) Pick(ctx context.Context, filters ...Filter) (Node, error) {
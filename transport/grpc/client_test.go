@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"github.com/go-slark/slark/registry"
+	"github.com/go-slark/slark/registry/memory"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"testing"
+	"time"
+)
+
+func TestDialDiscovery(t *testing.T) {
+	srv := NewServer(Address("127.0.0.1:0"))
+	go func() {
+		_ = srv.Start()
+	}()
+	defer srv.Stop(context.TODO())
+	<-srv.Ready()
+
+	r := memory.NewRegistry()
+	_ = r.Register(context.TODO(), &registry.Service{
+		ID:       "1",
+		Name:     "user-svc",
+		Endpoint: []string{"grpc://" + srv.listener.Addr().String()},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	conn, err := Dial(ctx, WithAddr("discovery:///user-svc"), WithDiscovery(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	rsp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatalf("unexpected status:%s", rsp.Status)
+	}
+}
@@ -3,7 +3,9 @@ package resolver
 import (
 	"context"
 	"github.com/go-slark/slark/errors"
+	"github.com/go-slark/slark/logger"
 	utils "github.com/go-slark/slark/pkg"
+	"github.com/go-slark/slark/pkg/retry"
 	"github.com/go-slark/slark/registry"
 	"google.golang.org/grpc/resolver"
 	"strings"
@@ -54,10 +56,11 @@ func (b *builder) Build(target resolver.Target, cc resolver.ClientConn, opts res
 		err     error
 		watcher registry.Watcher
 	)
+	name := strings.TrimPrefix(target.URL.Path, "/")
 	ch := make(chan struct{}, 1)
 	cx, cancel := context.WithCancel(context.Background())
 	go func() {
-		watcher, err = b.discovery.Discover(cx, strings.TrimPrefix(target.URL.Path, "/"))
+		watcher, err = b.discovery.Discover(cx, name)
 		ch <- struct{}{}
 	}()
 
@@ -75,6 +78,7 @@ func (b *builder) Build(target resolver.Target, cc resolver.ClientConn, opts res
 	}
 
 	p := &parser{
+		name:     name,
 		watcher:  watcher,
 		cancel:   cancel,
 		cc:       cc,
@@ -82,6 +86,9 @@ func (b *builder) Build(target resolver.Target, cc resolver.ClientConn, opts res
 		ss:       b.subset,
 		size:     b.size,
 		insecure: b.insecure,
+		backoff:  retry.NewOption(retry.Delay(100*time.Millisecond), retry.MaxJitter(100*time.Millisecond)),
+		maxDelay: 10 * time.Second,
+		logger:   logger.GetLogger(),
 	}
 	go p.watch()
 	return p, nil
@@ -3,15 +3,44 @@ package resolver
 import (
 	"context"
 	"github.com/go-slark/slark/errors"
+	"github.com/go-slark/slark/logger"
+	"github.com/go-slark/slark/middleware/metrics"
 	utils "github.com/go-slark/slark/pkg"
 	"github.com/go-slark/slark/pkg/endpoint"
+	"github.com/go-slark/slark/pkg/retry"
 	"github.com/go-slark/slark/registry"
 	"google.golang.org/grpc/attributes"
 	"google.golang.org/grpc/resolver"
+	"strconv"
 	"time"
 )
 
+const (
+	resultUpdate = "update"
+	resultEmpty  = "empty"
+	resultError  = "error"
+)
+
+var (
+	UpdateTotal = metrics.NewCounter(
+		metrics.Namespace("client"),
+		metrics.Name("update_total"),
+		metrics.Help("grpc resolver update count"),
+		metrics.SubSystem("resolver"),
+		metrics.Labels([]string{"service", "result"}),
+	)
+
+	AddressCount = metrics.NewGauge(
+		metrics.Namespace("client"),
+		metrics.Name("address_count"),
+		metrics.Help("grpc resolver address count"),
+		metrics.SubSystem("resolver"),
+		metrics.Labels([]string{"service"}),
+	)
+)
+
 type parser struct {
+	name     string
 	watcher  registry.Watcher
 	ctx      context.Context
 	cancel   context.CancelFunc
@@ -19,6 +48,9 @@ type parser struct {
 	ss       Subset
 	size     int
 	insecure bool
+	backoff  *retry.Option
+	maxDelay time.Duration
+	logger   logger.Logger
 }
 
 func (p *parser) ResolveNow(opts resolver.ResolveNowOptions) {}
@@ -29,31 +61,45 @@ func (p *parser) Close() {
 }
 
 func (p *parser) watch() {
+	var failures int
 	for {
-		select {
-		case <-p.ctx.Done():
-			return
-
-		default:
-			svc, err := p.watcher.List()
-			if err != nil {
-				if errors.Is(err, context.Canceled) {
-					return
-				}
-				time.Sleep(time.Second)
-				continue
+		snapshot, err := p.watcher.Next(p.ctx)
+		if err != nil {
+			if p.ctx.Err() != nil || errors.Is(err, context.Canceled) {
+				return
+			}
+			failures++
+			UpdateTotal.Values(p.name, resultError).Inc()
+			p.cc.ReportError(err)
+			d := p.delay(failures)
+			p.logger.Log(p.ctx, logger.WarnLevel, map[string]interface{}{"service": p.name, "error": err, "retry": failures, "delay": d}, "grpc resolver watch error")
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-time.After(d):
 			}
-			p.update(svc)
+			continue
 		}
+		failures = 0
+		p.update(snapshot)
+	}
+}
+
+// delay 指数退避 + 随机抖动
+func (p *parser) delay(n int) time.Duration {
+	d := retry.Group(retry.BackOff, retry.Random)(n, p.backoff)
+	if d > p.maxDelay {
+		d = p.maxDelay
 	}
+	return d
 }
 
-func (p *parser) update(svc []*registry.Service) {
+func (p *parser) update(snapshot *registry.Snapshot) {
 	mp := map[string]struct{}{}
-	set := make([]*registry.Service, 0, len(svc))
+	set := make([]*registry.Service, 0, len(snapshot.Services))
 	var ok bool
 	// filter
-	for _, s := range svc {
+	for _, s := range snapshot.Services {
 		addr, err := endpoint.ParseValidAddr(s.Endpoint, endpoint.Scheme("grpc", p.insecure))
 		if err != nil {
 			continue
@@ -68,7 +114,7 @@ func (p *parser) update(svc []*registry.Service) {
 	if p.ss != nil && p.size > 0 {
 		set = p.ss.Subset(set, p.size)
 	}
-	addresses := make([]resolver.Address, 0, len(svc))
+	addresses := make([]resolver.Address, 0, len(set))
 	for _, s := range set {
 		addr, _ := endpoint.ParseValidAddr(s.Endpoint, endpoint.Scheme("grpc", p.insecure))
 		address := resolver.Address{
@@ -78,8 +124,27 @@ func (p *parser) update(svc []*registry.Service) {
 		}
 		addresses = append(addresses, address)
 	}
+	fields := map[string]interface{}{
+		"service": p.name,
+		"version": strconv.FormatUint(snapshot.Version, 10),
+		"added":   len(snapshot.Added),
+		"removed": len(snapshot.Removed),
+	}
+	// 空列表保护: 注册中心短暂返回空列表时保留原有地址, 不清空连接
 	if len(addresses) == 0 {
+		UpdateTotal.Values(p.name, resultEmpty).Inc()
+		p.logger.Log(p.ctx, logger.WarnLevel, fields, "grpc resolver ignore empty address list")
+		return
+	}
+	err := p.cc.UpdateState(resolver.State{Addresses: addresses})
+	if err != nil {
+		fields["error"] = err
+		UpdateTotal.Values(p.name, resultError).Inc()
+		p.logger.Log(p.ctx, logger.WarnLevel, fields, "grpc resolver update state error")
 		return
 	}
-	_ = p.cc.UpdateState(resolver.State{Addresses: addresses})
+	UpdateTotal.Values(p.name, resultUpdate).Inc()
+	AddressCount.Values(p.name).Set(float64(len(addresses)))
+	fields["address"] = len(addresses)
+	p.logger.Log(p.ctx, logger.DebugLevel, fields, "grpc resolver update state")
 }
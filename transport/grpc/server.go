@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"crypto/tls"
+	xhealth "github.com/go-slark/slark/health"
 	"github.com/go-slark/slark/logger"
 	"github.com/go-slark/slark/middleware"
 	"github.com/go-slark/slark/middleware/flexible/breaker"
@@ -23,13 +24,18 @@ import (
 	"google.golang.org/grpc/reflection"
 	"net"
 	"net/url"
+	"sync"
 	"time"
 )
 
 type Server struct {
 	*grpc.Server
 	health   *health.Server
+	checker  *xhealth.Health
+	unsub    func()
 	listener net.Listener
+	ready    chan struct{}
+	once     sync.Once
 	tls      *tls.Config
 	err      error
 	logger   logger.Logger
@@ -48,6 +54,8 @@ func NewServer(opts ...ServerOption) *Server {
 		network: "tcp",
 		address: "0.0.0.0:9090",
 		health:  health.NewServer(),
+		checker: xhealth.GetHealth(),
+		ready:   make(chan struct{}),
 		logger:  logger.GetLogger(),
 		opts:    ServerOpts(),
 		enable:  0x63,
@@ -88,16 +96,38 @@ func NewServer(opts ...ServerOption) *Server {
 	return srv
 }
 
+func (s *Server) watchHealth() {
+	if s.checker == nil {
+		return
+	}
+	s.unsub = s.checker.Subscribe(func(status xhealth.Status) {
+		s.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_ServingStatus(status))
+	})
+	s.checker.Start()
+}
+
 func (s *Server) Start() error {
 	if s.err != nil {
 		return s.err
 	}
 	s.health.Resume()
+	s.watchHealth()
+	s.once.Do(func() {
+		close(s.ready)
+	})
 	return s.Serve(s.listener)
 }
 
+func (s *Server) Ready() <-chan struct{} {
+	return s.ready
+}
+
 func (s *Server) Stop(ctx context.Context) error {
 	s.health.Shutdown()
+	if s.checker != nil && s.unsub != nil {
+		s.unsub()
+		s.checker.Stop()
+	}
 	s.GracefulStop()
 	return nil
 }
@@ -161,6 +191,13 @@ func Logger(logger logger.Logger) ServerOption {
 	}
 }
 
+// Health 探针集合, 为nil时只提供默认的grpc.health.v1.Health状态
+func Health(h *xhealth.Health) ServerOption {
+	return func(s *Server) {
+		s.checker = h
+	}
+}
+
 func Enable(enable int64) ServerOption {
 	return func(server *Server) {
 		server.enable = enable
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"github.com/go-slark/slark/errors"
 	"github.com/go-slark/slark/middleware"
 	utils "github.com/go-slark/slark/pkg"
 	"github.com/go-slark/slark/pkg/trace"
@@ -35,9 +36,10 @@ func (s *Server) unaryServerInterceptor() grpc.UnaryServerInterceptor {
 			ctx, cancel = context.WithTimeout(ctx, s.timeout)
 			defer cancel()
 		}
-		return middleware.ComposeMiddleware(s.mws...)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		rsp, err := middleware.ComposeMiddleware(s.mws...)(func(ctx context.Context, req interface{}) (interface{}, error) {
 			return handler(ctx, req)
 		})(ctx, req)
+		return rsp, errors.Localize(err, trans.req.Get(utils.AcceptLanguage))
 	}
 }
 
@@ -56,7 +58,7 @@ func (s *Server) streamServerInterceptor() grpc.StreamServerInterceptor {
 		_, err := middleware.ComposeMiddleware(s.mws...)(func(ctx context.Context, req interface{}) (interface{}, error) {
 			return nil, handler(srv, &ssWrapper{ctx: ctx, ServerStream: ss})
 		})(ctx, nil)
-		return err
+		return errors.Localize(err, trans.req.Get(utils.AcceptLanguage))
 	}
 }
 
@@ -0,0 +1,244 @@
+package http
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/go-slark/slark/errors"
+	utils "github.com/go-slark/slark/pkg"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// templateVar google.api.http路径模板中的变量, parts为gin路由中的字面量或参数
+type templateVar struct {
+	field string
+	parts []pathPart
+}
+
+type pathPart struct {
+	literal  string
+	param    string
+	catchAll bool
+}
+
+// compileTemplate google.api.http路径模板转换为gin路由:
+// {id} -> :id, {name=shelves/*/books/*} -> shelves/:name_1/books/:name_2, {name=**} -> *name_1
+func compileTemplate(template string) (string, []*templateVar) {
+	var vars []*templateVar
+	path := pathVar.ReplaceAllStringFunc(template, func(s string) string {
+		match := pathVar.FindStringSubmatch(s)
+		v := &templateVar{field: match[1]}
+		vars = append(vars, v)
+		if len(match[2]) == 0 || strings.TrimSpace(match[2][1:]) == "*" {
+			v.parts = append(v.parts, pathPart{param: v.field})
+			return ":" + v.field
+		}
+		segments := strings.Split(strings.TrimSpace(match[2][1:]), "/")
+		for i, segment := range segments {
+			switch segment {
+			case "*":
+				v.parts = append(v.parts, pathPart{param: fmt.Sprintf("%s_%d", v.field, i)})
+				segments[i] = ":" + v.parts[len(v.parts)-1].param
+			case "**":
+				v.parts = append(v.parts, pathPart{param: fmt.Sprintf("%s_%d", v.field, i), catchAll: true})
+				segments[i] = "*" + v.parts[len(v.parts)-1].param
+			default:
+				v.parts = append(v.parts, pathPart{literal: segment})
+			}
+		}
+		return strings.Join(segments, "/")
+	})
+	return path, vars
+}
+
+// value 由gin参数还原变量值, 如: shelves/1/books/2
+func (v *templateVar) value(params gin.Params) string {
+	values := make([]string, 0, len(v.parts))
+	for _, part := range v.parts {
+		if len(part.param) == 0 {
+			values = append(values, part.literal)
+			continue
+		}
+		value := params.ByName(part.param)
+		if part.catchAll {
+			value = strings.TrimPrefix(value, "/")
+		}
+		values = append(values, value)
+	}
+	return strings.Join(values, "/")
+}
+
+// Bind 按google.api.http规则绑定请求: body为"*"时请求体绑定到v且忽略query, 为字段名时请求体绑定到该字段,
+// 其余字段来自query, 最后绑定path参数; 同一字段由多个来源赋不同值时返回errors.BadRequest及字段违例
+func (c *Context) Bind(v proto.Message, body string) error {
+	codecs := c.router.srv.codecs
+	switch body {
+	case "":
+	case "*":
+		if err := codecs.bodyDecoder(c.req, v); err != nil {
+			return err
+		}
+	default:
+		target, err := bodyField(v, body)
+		if err != nil {
+			return err
+		}
+		if err = codecs.bodyDecoder(c.req, target); err != nil {
+			return err
+		}
+	}
+
+	vars, _ := c.req.Context().Value(utils.RequestVars).(map[string]string)
+	var e *errors.Error
+	violation := func(field, desc string) {
+		if e == nil {
+			e = errors.BadRequest("request binding conflict", errors.ParamError)
+		}
+		e = e.WithFieldViolation(field, desc)
+	}
+
+	if body != "*" {
+		query := c.req.URL.Query()
+		for key, values := range query {
+			if len(body) > 0 && (key == body || strings.HasPrefix(key, body+".")) {
+				violation(key, fmt.Sprintf("field is bound by request body '%s'", body))
+				query.Del(key)
+				continue
+			}
+			if value, ok := vars[key]; ok {
+				if len(values) > 0 && values[0] != value {
+					violation(key, fmt.Sprintf("query value '%s' conflicts with path value '%s'", values[0], value))
+				}
+				query.Del(key)
+			}
+		}
+		if len(query) > 0 {
+			req := *c.req
+			u := *c.req.URL
+			u.RawQuery = query.Encode()
+			req.URL = &u
+			if err := codecs.queryDecoder(&req, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(vars) > 0 {
+		bound := v.ProtoReflect().New().Interface()
+		values := make(url.Values, len(vars))
+		for key, value := range vars {
+			values.Set(key, value)
+		}
+		if err := decode(values, bound); err != nil {
+			return err
+		}
+		for key := range vars {
+			if has(v.ProtoReflect(), key) && !equal(v.ProtoReflect(), bound.ProtoReflect(), key) {
+				violation(key, "body value conflicts with path value")
+			}
+		}
+		if err := codecs.varsDecoder(c.req, v); err != nil {
+			return err
+		}
+	}
+	if e != nil {
+		return e
+	}
+	return nil
+}
+
+// bodyField 返回body字段的指针, message字段使用Mutable以兼容所有codec
+func bodyField(v proto.Message, body string) (interface{}, error) {
+	m := v.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(body))
+	if fd == nil {
+		return nil, errors.BadRequest(errors.ParamError, fmt.Sprintf("body field '%s' not found", body))
+	}
+	if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+		return m.Mutable(fd).Message().Interface(), nil
+	}
+	rv := reflect.ValueOf(v).Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		tag := rv.Type().Field(i).Tag.Get("protobuf")
+		for _, opt := range strings.Split(tag, ",") {
+			if opt == "name="+body {
+				return rv.Field(i).Addr().Interface(), nil
+			}
+		}
+	}
+	return nil, errors.BadRequest(errors.ParamError, fmt.Sprintf("body field '%s' not found", body))
+}
+
+func field(m protoreflect.Message, path string) (protoreflect.Message, protoreflect.FieldDescriptor) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = m.Descriptor().Fields().ByJSONName(name)
+		}
+		if fd == nil {
+			return nil, nil
+		}
+		if i == len(names)-1 {
+			return m, fd
+		}
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() || !m.Has(fd) {
+			return nil, nil
+		}
+		m = m.Get(fd).Message()
+	}
+	return nil, nil
+}
+
+func has(m protoreflect.Message, path string) bool {
+	parent, fd := field(m, path)
+	return fd != nil && parent.Has(fd)
+}
+
+func equal(a, b protoreflect.Message, path string) bool {
+	pa, fa := field(a, path)
+	pb, fb := field(b, path)
+	if fa == nil || fb == nil {
+		return fa == fb
+	}
+	return pa.Get(fa).Equal(pb.Get(fb))
+}
+
+// splitVerb 分离变量之后的自定义方法, 如: /v1/users/{id}:watch -> /v1/users/{id}, watch
+// gin无法在参数后接字面量, 字面量段中的:verb(如/v1/users:batchGet)仍由gin匹配
+func splitVerb(template string) (string, string) {
+	i := strings.LastIndexByte(template, '}')
+	if i < 0 {
+		return template, ""
+	}
+	j := strings.IndexByte(template[i:], ':')
+	if j < 0 || strings.Contains(template[i:], "/") {
+		return template, ""
+	}
+	return template[:i+j], template[i+j+1:]
+}
+
+// route 返回gin路由, 自定义方法及请求中path参数的解析, google.api.http变量还原为字段路径, 如: {name=shelves/*} -> name
+func route(template string) (string, string, func(*gin.Context) map[string]string) {
+	template, verb := splitVerb(template)
+	if !strings.Contains(template, "{") {
+		return template, verb, func(ctx *gin.Context) map[string]string {
+			mp := make(map[string]string, len(ctx.Params))
+			for _, param := range ctx.Params {
+				mp[param.Key] = param.Value
+			}
+			return mp
+		}
+	}
+	path, vars := compileTemplate(template)
+	return path, verb, func(ctx *gin.Context) map[string]string {
+		mp := make(map[string]string, len(vars))
+		for _, v := range vars {
+			mp[v.field] = v.value(ctx.Params)
+		}
+		return mp
+	}
+}
@@ -0,0 +1,111 @@
+package http
+
+import (
+	"github.com/go-slark/slark/errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCompileTemplate(t *testing.T) {
+	cases := map[string]string{
+		"/v1/users/{id}":                 "/v1/users/:id",
+		"/v1/users/{user.id}":            "/v1/users/:user.id",
+		"/v1/{name=shelves/*}":           "/v1/shelves/:name_1",
+		"/v1/{name=shelves/*/books/*}/x": "/v1/shelves/:name_1/books/:name_3/x",
+		"/v1/files/{path=**}":            "/v1/files/*path_0",
+		"/v1/{ name = orgs/*/users/** }": "/v1/orgs/:name_1/users/*name_3",
+	}
+	for template, want := range cases {
+		if path, _ := compileTemplate(template); path != want {
+			t.Errorf("compileTemplate(%s) = %s, want %s", template, path, want)
+		}
+	}
+}
+
+func TestBind(t *testing.T) {
+	srv := NewServer(Address("127.0.0.1:0"))
+	defer srv.listener.Close()
+	r := NewRouter(srv)
+	bind := func(body string) HandlerFunc {
+		return func(ctx *Context) error {
+			in := &errors.Status{}
+			if err := ctx.Bind(in, body); err != nil {
+				return err
+			}
+			return ctx.Result(in)
+		}
+	}
+	r.Handle(http.MethodGet, "/v1/{reason=orgs/*/users/**}", bind(""))
+	r.Handle(http.MethodPut, "/v1/status/{code}", bind("*"))
+	r.Handle(http.MethodPatch, "/v1/status/{code}", bind("metadata"))
+
+	do := func(method, path, body string) (int, string) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		srv.Handler.ServeHTTP(rec, req)
+		return rec.Code, rec.Body.String()
+	}
+	cases := []struct {
+		method, path, body string
+		code               int
+		want               string
+	}{
+		{http.MethodGet, "/v1/orgs/1/users/a/b?code=3&message=m", "", 200, `"code":3,"reason":"orgs/1/users/a/b","message":"m"`},
+		{http.MethodGet, "/v1/orgs/1/users/a?reason=x", "", 400, `"field":"reason"`},
+		{http.MethodPut, "/v1/status/404?message=ignored", `{"reason":"r"}`, 200, `"code":404,"reason":"r","message":""`},
+		{http.MethodPut, "/v1/status/404", `{"code":500}`, 400, `"description":"body value conflicts with path value","field":"code"`},
+		{http.MethodPatch, "/v1/status/404?reason=q", `{"k":"v"}`, 200, `"code":404,"reason":"q","message":"","metadata":{"k":"v"}`},
+		{http.MethodPatch, "/v1/status/404?metadata.k=x", `{"k":"v"}`, 400, `"field":"metadata.k"`},
+	}
+	for _, c := range cases {
+		code, body := do(c.method, c.path, c.body)
+		if code != c.code || !strings.Contains(body, c.want) {
+			t.Errorf("%s %s = %d %s, want %d %s", c.method, c.path, code, body, c.code, c.want)
+		}
+	}
+}
+
+func TestVerb(t *testing.T) {
+	srv := NewServer(Address("127.0.0.1:0"))
+	defer srv.listener.Close()
+	r := NewRouter(srv)
+	handle := func(name string) HandlerFunc {
+		return func(ctx *Context) error {
+			in := &errors.Status{}
+			if err := ctx.Bind(in, ""); err != nil {
+				return err
+			}
+			in.Message = name
+			return ctx.Result(in)
+		}
+	}
+	// GetUser / WatchUser 共用gin路由 /v1/users/:reason
+	r.Handle(http.MethodGet, "/v1/users/{reason}", handle("get"))
+	r.Handle(http.MethodGet, "/v1/users/{reason}:watch", handle("watch"))
+	r.Handle(http.MethodPost, "/v1/{reason=shelves/*}:move", handle("move"))
+
+	cases := []struct {
+		method, path string
+		code         int
+		want         string
+	}{
+		{http.MethodGet, "/v1/users/1", 200, `"reason":"1","message":"get"`},
+		{http.MethodGet, "/v1/users/1:watch", 200, `"reason":"1","message":"watch"`},
+		{http.MethodGet, "/v1/users/a:b", 200, `"reason":"a:b","message":"get"`},
+		{http.MethodPost, "/v1/shelves/1:move", 200, `"reason":"shelves/1","message":"move"`},
+		{http.MethodPost, "/v1/shelves/1", 404, ""},
+	}
+	for _, c := range cases {
+		rec := httptest.NewRecorder()
+		srv.Handler.ServeHTTP(rec, httptest.NewRequest(c.method, c.path, nil))
+		if rec.Code != c.code || !strings.Contains(rec.Body.String(), c.want) {
+			t.Errorf("%s %s = %d %s, want %d %s", c.method, c.path, rec.Code, rec.Body.String(), c.code, c.want)
+		}
+	}
+	if path, verb := splitVerb("/v1/users:batchGet"); path != "/v1/users:batchGet" || verb != "" {
+		t.Errorf("splitVerb = %s %s", path, verb)
+	}
+}
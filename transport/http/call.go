@@ -0,0 +1,118 @@
+package http
+
+import (
+	"context"
+	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/encoding/form"
+	"github.com/go-slark/slark/encoding/json"
+	utils "github.com/go-slark/slark/pkg"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// CallOption 生成客户端单次调用的选项
+type CallOption func(*Request)
+
+func CallHeader(header map[string]string) CallOption {
+	return func(r *Request) {
+		for k, v := range header {
+			r.header[k] = v
+		}
+	}
+}
+
+func CallDecoder(dec Decoder) CallOption {
+	return func(r *Request) {
+		r.decoder = dec
+	}
+}
+
+// Invoke 供protoc-gen-http生成的客户端调用, 请求经过客户端middleware, 响应{code,msg,data}中的data解码到out
+func (c *Client) Invoke(ctx context.Context, method, path string, in, out interface{}, opts ...CallOption) error {
+	req := NewRequest().Method(method).URL(path).Decoder(EnvelopeDecoder).Header(map[string]string{
+		utils.ContentType: SetContentType(json.Name),
+		utils.Accept:      SetContentType(json.Name),
+	})
+	if in != nil {
+		req.Param(in)
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+	return c.DoHTTPReq(ctx, req, out)
+}
+
+// EnvelopeDecoder 解码ResponseEncoder写出的{code,msg,data}, code非0时返回errors.Error
+func EnvelopeDecoder(_ context.Context, rsp *http.Response, v interface{}) error {
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+	return decodeResponse(responseCodec(rsp), body, v)
+}
+
+// RawDecoder 解码RawEnvelope写出的未封装响应
+func RawDecoder(_ context.Context, rsp *http.Response, v interface{}) error {
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+	return responseCodec(rsp).Unmarshal(body, v)
+}
+
+func responseCodec(rsp *http.Response) encoding.Codec {
+	codec := encoding.GetCodec(SubContentType(rsp.Header.Get(utils.ContentType)))
+	if codec == nil {
+		codec = encoding.GetCodec(json.Name)
+	}
+	return codec
+}
+
+var pathVar = regexp.MustCompile(`{\s*([a-zA-Z0-9_.]+)\s*(=[^{}]*)?}`)
+
+// EncodeURL 使用msg字段展开google.api.http路径模板中的{field} / {field=pattern},
+// query为true时其余字段编码为query参数, exclude为放入body的字段
+func EncodeURL(template string, msg proto.Message, query bool, exclude ...string) string {
+	if msg == nil {
+		return template
+	}
+	bound := make([]string, 0, 2)
+	path := pathVar.ReplaceAllStringFunc(template, func(s string) string {
+		match := pathVar.FindStringSubmatch(s)
+		bound = append(bound, match[1])
+		value, _ := form.Field(msg, match[1])
+		if len(match[2]) == 0 {
+			return url.PathEscape(value)
+		}
+		// 多段路径参数保留'/'
+		segments := strings.Split(value, "/")
+		for i, segment := range segments {
+			segments[i] = url.PathEscape(segment)
+		}
+		return strings.Join(segments, "/")
+	})
+	if !query {
+		return path
+	}
+	values, err := form.Encode(msg)
+	if err != nil || len(values) == 0 {
+		return path
+	}
+	bound = append(bound, exclude...)
+	for key := range values {
+		for _, field := range bound {
+			if key == field || strings.HasPrefix(key, field+".") {
+				values.Del(key)
+				break
+			}
+		}
+	}
+	if len(values) == 0 {
+		return path
+	}
+	return path + "?" + values.Encode()
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/go-slark/slark/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEncodeURL(t *testing.T) {
+	in := &errors.Status{Code: 404, Reason: "orgs/1/users/a b", Message: "not found", Metadata: map[string]string{"uid": "1"}}
+	cases := []struct {
+		template string
+		query    bool
+		exclude  []string
+		want     string
+	}{
+		{"/v1/status/{code}", true, nil, "/v1/status/404?message=not+found&metadata.uid=1&reason=orgs%2F1%2Fusers%2Fa+b"},
+		{"/v1/{reason=orgs/*/users/*}:get", true, []string{"metadata"}, "/v1/orgs/1/users/a%20b:get?code=404&message=not+found"},
+		{"/v1/status/{code}", false, nil, "/v1/status/404"},
+		{"/v1/status/{code}", true, []string{"message", "metadata", "reason"}, "/v1/status/404"},
+	}
+	for _, c := range cases {
+		if got := EncodeURL(c.template, in, c.query, c.exclude...); got != c.want {
+			t.Errorf("EncodeURL(%s) = %s, want %s", c.template, got, c.want)
+		}
+	}
+	if got := EncodeURL("/v1/status/{code}", &errors.Status{}, true); got != "/v1/status/0" {
+		t.Errorf("unexpected zero value path %s", got)
+	}
+}
+
+func TestInvoke(t *testing.T) {
+	engine := gin.New()
+	engine.Any("/*path", func(ctx *gin.Context) {
+		r := ctx.Request
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/status/400" || r.URL.Query().Get("reason") != "x" {
+			ErrorEncoder(r, ctx.Writer, errors.BadRequest("unexpected request", r.URL.String()))
+			return
+		}
+		_ = ResponseEncoder(r, ctx.Writer, &errors.Status{Code: 400, Reason: "x", Metadata: map[string]string{"k": "v"}})
+	})
+	srv := httptest.NewServer(engine)
+	defer srv.Close()
+
+	client := NewClient(WithTarget(srv.URL))
+	in := &errors.Status{Code: 400, Reason: "x"}
+	out := &errors.Status{}
+	err := client.Invoke(context.Background(), http.MethodGet, EncodeURL("/v1/status/{code}", in, true), nil, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Reason != "x" || out.Metadata["k"] != "v" {
+		t.Fatalf("unexpected reply %v", out)
+	}
+
+	err = client.Invoke(context.Background(), http.MethodPost, "/v1/status/1", in, out)
+	if e := errors.FromError(err); e.Code != http.StatusBadRequest || e.Message != "unexpected request" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+// TestInvokeRouter 与生成代码一致: 服务端按google.api.http路由, 客户端EncodeURL展开路径, response_body解码到&out.Field
+func TestInvokeRouter(t *testing.T) {
+	srv := NewServer(Address("127.0.0.1:0"))
+	defer srv.listener.Close()
+	r := NewRouter(srv)
+	r.Handle(http.MethodGet, "/v1/status/{reason}", func(ctx *Context) error {
+		in := &errors.Status{}
+		if err := ctx.Bind(in, ""); err != nil {
+			return err
+		}
+		return ctx.Result(in)
+	})
+	r.Handle(http.MethodGet, "/v1/retry/{reason}", func(ctx *Context) error {
+		out := &errdetails.RetryInfo{RetryDelay: durationpb.New(3 * time.Second)}
+		return ctx.Result(out.RetryDelay)
+	})
+	r.Handle(http.MethodGet, "/v1/metadata/{reason}", func(ctx *Context) error {
+		in := &errors.Status{}
+		if err := ctx.Bind(in, ""); err != nil {
+			return err
+		}
+		out := &errors.Status{Metadata: map[string]string{"reason": in.Reason}}
+		return ctx.Result(out.Metadata)
+	})
+	hs := httptest.NewServer(srv.Handler)
+	defer hs.Close()
+	client := NewClient(WithTarget(hs.URL))
+
+	// 单段path参数中的'/'
+	in := &errors.Status{Reason: "a/b c"}
+	out := &errors.Status{}
+	if err := client.Invoke(context.Background(), http.MethodGet, EncodeURL("/v1/status/{reason}", in, false), nil, out); err != nil || out.Reason != "a/b c" {
+		t.Fatalf("unexpected reply %v %v", out, err)
+	}
+
+	// response_body为message字段
+	var retry errdetails.RetryInfo
+	if err := client.Invoke(context.Background(), http.MethodGet, EncodeURL("/v1/retry/{reason}", in, false), nil, &retry.RetryDelay); err != nil || retry.RetryDelay.AsDuration() != 3*time.Second {
+		t.Fatalf("unexpected retry %v %v", retry.RetryDelay, err)
+	}
+
+	// response_body为map字段
+	var status errors.Status
+	if err := client.Invoke(context.Background(), http.MethodGet, EncodeURL("/v1/metadata/{reason}", in, false), nil, &status.Metadata); err != nil || status.Metadata["reason"] != "a/b c" {
+		t.Fatalf("unexpected metadata %v %v", status.Metadata, err)
+	}
+}
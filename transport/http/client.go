@@ -4,11 +4,26 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"fmt"
 	"github.com/go-slark/slark/encoding"
 	"github.com/go-slark/slark/errors"
+	"github.com/go-slark/slark/logger"
+	"github.com/go-slark/slark/middleware"
+	"github.com/go-slark/slark/middleware/flexible/breaker"
+	"github.com/go-slark/slark/middleware/logging"
+	"github.com/go-slark/slark/middleware/metrics"
+	"github.com/go-slark/slark/middleware/recovery"
+	"github.com/go-slark/slark/middleware/tracing"
 	utils "github.com/go-slark/slark/pkg"
-	"io"
+	"github.com/go-slark/slark/pkg/endpoint"
+	"github.com/go-slark/slark/registry"
+	"github.com/go-slark/slark/transport"
+	"github.com/go-slark/slark/transport/grpc/balancer/algo"
+	"github.com/go-slark/slark/transport/grpc/balancer/node"
+	"go.opentelemetry.io/otel/trace"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -16,20 +31,42 @@ type Client struct {
 	*http.Client
 	transport http.RoundTripper
 	tls       *tls.Config
+	timeout   time.Duration
+	target    string
+	enable    int64
+	logger    logger.Logger
+	mws       []middleware.Middleware
+	discovery registry.Discovery
+	builder   node.Builder
+	filters   []node.Filter
+	resolver  *resolver
 }
 
 type ClientOption func(client *Client)
 
 func NewClient(opts ...ClientOption) *Client {
 	client := &Client{
-		Client: &http.Client{
-			Timeout: 3 * time.Second,
-		},
+		Client:    &http.Client{},
 		transport: http.DefaultTransport,
+		timeout:   3 * time.Second,
+		logger:    logger.GetLogger(),
+		builder:   algo.NewWRRBuilder(),
+		enable:    0x03,
 	}
 	for _, opt := range opts {
 		opt(client)
 	}
+	// 默认中间件在options之后构建, 使WithLogger生效
+	if client.mws == nil {
+		client.mws = []middleware.Middleware{
+			tracing.Trace(trace.SpanKindClient),
+			logging.Log(middleware.Client, client.logger),
+			metrics.Metrics(middleware.Client, metrics.WithCounter(metrics.RequestTotal)),
+			breaker.Breaker(),
+			recovery.Recovery(client.logger),
+		}
+	}
+	client.mws = utils.Filter(client.mws, client.enable)
 	if client.tls != nil {
 		transport, ok := client.transport.(*http.Transport)
 		if ok {
@@ -37,12 +74,18 @@ func NewClient(opts ...ClientOption) *Client {
 		}
 	}
 	client.Client.Transport = client.transport
+	// discovery:///user-svc
+	u, err := url.Parse(client.target)
+	if err == nil && u.Scheme == utils.Discovery && client.discovery != nil {
+		client.resolver = newResolver(client.discovery, strings.TrimPrefix(u.Path, "/"), client.builder, client.tls == nil)
+	}
 	return client
 }
 
+// Timeout ctx未设置deadline时每个请求的超时时间
 func Timeout(tm time.Duration) ClientOption {
 	return func(client *Client) {
-		client.Timeout = tm
+		client.timeout = tm
 	}
 }
 
@@ -58,6 +101,49 @@ func WithTLS(tls *tls.Config) ClientOption {
 	}
 }
 
+// WithTarget http://127.0.0.1:8080 or discovery:///user-svc, Request.URL为相对路径时拼接
+func WithTarget(target string) ClientOption {
+	return func(client *Client) {
+		client.target = target
+	}
+}
+
+func WithDiscovery(discovery registry.Discovery) ClientOption {
+	return func(client *Client) {
+		client.discovery = discovery
+	}
+}
+
+func WithBalancer(builder node.Builder) ClientOption {
+	return func(client *Client) {
+		client.builder = builder
+	}
+}
+
+func WithFilters(filters ...node.Filter) ClientOption {
+	return func(client *Client) {
+		client.filters = filters
+	}
+}
+
+func WithMiddleware(mws ...middleware.Middleware) ClientOption {
+	return func(client *Client) {
+		client.mws = mws
+	}
+}
+
+func WithLogger(l logger.Logger) ClientOption {
+	return func(client *Client) {
+		client.logger = l
+	}
+}
+
+func WithEnable(enable int64) ClientOption {
+	return func(client *Client) {
+		client.enable = enable
+	}
+}
+
 type Encoder func(ctx context.Context, typ string, v interface{}) ([]byte, error)
 
 type Decoder func(ctx context.Context, rsp *http.Response, v interface{}) error
@@ -80,25 +166,9 @@ func NewRequest() *Request {
 			return encoding.GetCodec(SubContentType(typ)).Marshal(v)
 		},
 
-		decoder: func(ctx context.Context, rsp *http.Response, v interface{}) error {
-			body, err := io.ReadAll(rsp.Body)
-			if err != nil {
-				return err
-			}
-			codec := encoding.GetCodec(SubContentType(rsp.Header.Get(utils.ContentType)))
-			if codec == nil {
-				codec = encoding.GetCodec("json")
-			}
-			return codec.Unmarshal(body, v)
-		},
+		decoder: RawDecoder,
 
-		errDecoder: func(ctx context.Context, rsp *http.Response) error {
-			if rsp.StatusCode >= http.StatusOK && rsp.StatusCode < http.StatusMultipleChoices {
-				return nil
-			}
-			body, err := io.ReadAll(rsp.Body)
-			return errors.New(rsp.StatusCode, errors.UnknownReason, errors.UnknownReason).WithError(err).WithReason(string(body))
-		},
+		errDecoder: ErrorDecoder,
 	}
 	return req
 }
@@ -139,34 +209,89 @@ func (r *Request) ErrDecoder(errDec ErrDecoder) *Request {
 }
 
 func (c *Client) DoHTTPReq(ctx context.Context, req *Request, v interface{}) error {
-	var body io.Reader
+	var body []byte
 	if req.param != nil {
-		enc, err := req.encoder(ctx, req.header["Content-Type"], req.param)
+		enc, err := req.encoder(ctx, req.header[utils.ContentType], req.param)
 		if err != nil {
 			return err
 		}
-		body = bytes.NewReader(enc)
-	}
-
-	request, err := http.NewRequest(req.method, req.url, body)
-	if err != nil {
-		return err
+		body = enc
 	}
 
+	header := make(http.Header, len(req.header))
 	for hk, hv := range req.header {
-		request.Header.Set(hk, hv)
+		header.Set(hk, hv)
+	}
+	trans := &Transport{
+		Operation: fmt.Sprintf("%s %s", req.method, req.url),
+		Req:       Carrier(header),
+		Rsp:       Carrier{},
 	}
+	ctx = transport.NewClientContext(ctx, trans)
+	if _, ok := ctx.Deadline(); !ok && c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	_, err := middleware.ComposeMiddleware(c.mws...)(func(ctx context.Context, _ interface{}) (_ interface{}, err error) {
+		target, done, err := c.resolve(ctx, req.url)
+		if err != nil {
+			return nil, err
+		}
+		if done != nil {
+			defer func() {
+				done(ctx, node.DoneInfo{Err: err})
+			}()
+		}
+		request, err := http.NewRequestWithContext(ctx, req.method, target, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		request.Header = header
+
+		rsp, err := c.Do(request)
+		if err != nil {
+			return nil, err
+		}
+		defer rsp.Body.Close()
+		trans.Rsp = Carrier(rsp.Header)
+
+		err = req.errDecoder(ctx, rsp)
+		if err != nil {
+			return nil, err
+		}
+		return v, req.decoder(ctx, rsp, v)
+	})(ctx, req.param)
+	return err
+}
 
-	rsp, err := c.Do(request)
+// resolve 绝对URL直接请求, 相对路径拼接target或由balancer选取节点
+func (c *Client) resolve(ctx context.Context, path string) (string, node.DoneFunc, error) {
+	u, err := url.Parse(path)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
-	defer rsp.Body.Close()
-
-	err = req.errDecoder(ctx, rsp)
+	if u.IsAbs() {
+		return path, nil, nil
+	}
+	if c.resolver == nil {
+		return c.target + path, nil, nil
+	}
+	n, err := c.resolver.pick(ctx, c.filters...)
 	if err != nil {
-		return err
+		return "", nil, errors.ServerUnavailable("http client pick node", err.Error())
 	}
+	var done node.DoneFunc
+	fb, ok := n.(node.Feedback)
+	if ok {
+		done = fb.Pick()
+	}
+	return fmt.Sprintf("%s://%s%s", endpoint.Scheme("http", c.tls == nil), n.Address(), path), done, nil
+}
 
-	return req.decoder(ctx, rsp, v)
+func (c *Client) Close() error {
+	if c.resolver != nil {
+		return c.resolver.close()
+	}
+	return nil
 }
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-slark/slark/middleware"
+	"github.com/go-slark/slark/registry"
+	"github.com/go-slark/slark/transport"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type discovery struct {
+	svc []*registry.Service
+}
+
+func (d *discovery) Discover(ctx context.Context, _ string) (registry.Watcher, error) {
+	return &watcher{ctx: ctx, svc: d.svc, ch: make(chan struct{}, 1)}, nil
+}
+
+type watcher struct {
+	ctx  context.Context
+	svc  []*registry.Service
+	ch   chan struct{}
+	done bool
+}
+
+func (w *watcher) Next(ctx context.Context) (*registry.Snapshot, error) {
+	if !w.done {
+		w.done = true
+		return &registry.Snapshot{Version: 1, Services: w.svc, Added: w.svc}, nil
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-w.ctx.Done():
+		return nil, w.ctx.Err()
+	}
+}
+
+func (w *watcher) List() ([]*registry.Service, error) {
+	s, err := w.Next(w.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Services, nil
+}
+
+func (w *watcher) Stop() error {
+	return nil
+}
+
+func TestClientDiscovery(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"path":%q}`, r.URL.Path)
+	}))
+	defer srv.Close()
+
+	var operation string
+	mw := func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			trans, ok := transport.FromClientContext(ctx)
+			if ok {
+				operation = trans.Operate()
+			}
+			return handler(ctx, req)
+		}
+	}
+	dis := &discovery{svc: []*registry.Service{{Name: "user-svc", Endpoint: []string{"http://" + srv.Listener.Addr().String()}}}}
+	client := NewClient(WithTarget("discovery:///user-svc"), WithDiscovery(dis), WithMiddleware(mw), WithEnable(0x01))
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	rsp := map[string]string{}
+	err := client.DoHTTPReq(ctx, NewRequest().Method(http.MethodGet).URL("/v1/users"), &rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp["path"] != "/v1/users" {
+		t.Fatalf("unexpected response:%v", rsp)
+	}
+	if operation != "GET /v1/users" {
+		t.Fatalf("unexpected operation:%s", operation)
+	}
+}
+
+type countLogger struct {
+	n int32
+}
+
+func (l *countLogger) Log(context.Context, uint, map[string]interface{}, ...interface{}) {
+	atomic.AddInt32(&l.n, 1)
+}
+
+func TestClientLogger(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer srv.Close()
+
+	l := &countLogger{}
+	client := NewClient(WithTarget(srv.URL), WithLogger(l))
+	err := client.DoHTTPReq(context.Background(), NewRequest().Method(http.MethodGet).URL("/v1/users"), &map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&l.n) != 2 {
+		t.Fatalf("WithLogger not applied to logging middleware, logs:%d", l.n)
+	}
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"fmt"
 	"github.com/go-slark/slark/encoding"
 	"github.com/go-slark/slark/encoding/form"
@@ -98,54 +99,73 @@ func SetContentType(subtype string) string {
 }
 
 type Header struct {
-	Code int    `json:"code"`
-	Msg  string `json:"msg"`
+	Code int    `json:"code" msgpack:"code"`
+	Msg  string `json:"msg" msgpack:"msg"`
 }
 
 type Response struct {
-	*Header
-	Data interface{} `json:"data"`
+	*Header `yaml:",inline"`
+	Data    interface{} `json:"data" msgpack:"data"`
 }
 
 func ResponseEncoder(req *http.Request, rsp http.ResponseWriter, v interface{}) error {
-	r := &Response{
-		Header: &Header{
-			Msg: "成功",
-		},
-		Data: v,
-	}
+	return EnvelopeEncoder(DefaultEnvelope)(req, rsp, v)
+}
 
-	codec, _ := Codec(req, utils.Accept)
-	hb, err := codec.Marshal(r.Header)
-	if err != nil {
-		return err
-	}
-	pb, err := codec.Marshal(r.Data)
-	if err != nil {
+// EnvelopeEncoder 按Accept协商的codec编码env封装后的响应
+func EnvelopeEncoder(env Envelope) func(*http.Request, http.ResponseWriter, interface{}) error {
+	return func(req *http.Request, rsp http.ResponseWriter, v interface{}) error {
+		codec, _ := Codec(req, utils.Accept)
+		data, err := encodeResponse(env, codec, v)
+		if err != nil {
+			return err
+		}
+		rsp.Header().Set(utils.ContentType, SetContentType(codec.Name()))
+		rsp.WriteHeader(http.StatusOK)
+		_, err = rsp.Write(data)
 		return err
 	}
-	data := make([]byte, 0, len(hb)+len(pb)+8)
-	data = append(data, hb[:len(hb)-1]...)
-	data = append(data, []byte(`,"data":`)...)
-	data = append(data, pb...)
-	data = append(data, '}')
-	rsp.Header().Set(utils.ContentType, SetContentType(codec.Name()))
-	rsp.WriteHeader(0)
-	_, err = rsp.Write(data)
-	return err
+}
+
+// ErrorResponse 错误响应, details为带@type的google.rpc错误详情
+type ErrorResponse struct {
+	*Header  `yaml:",inline"`
+	Reason   string            `json:"reason,omitempty" yaml:"reason,omitempty" msgpack:"reason,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty" msgpack:"metadata,omitempty"`
+	Details  []map[string]any  `json:"details,omitempty" yaml:"details,omitempty" msgpack:"details,omitempty"`
+	Data     interface{}       `json:"data" msgpack:"data"`
 }
 
 func ErrorEncoder(req *http.Request, rsp http.ResponseWriter, err error) {
-	e := errors.FromError(err)
-	response := &Response{
-		Header: &Header{
-			Code: int(e.Code),
-			Msg:  e.Message,
-		},
-	}
-	codec, _ := Codec(req, utils.Accept)
-	data, _ := codec.Marshal(response)
-	rsp.Header().Set(utils.ContentType, SetContentType(codec.Name()))
-	rsp.WriteHeader(int(e.Code))
-	_, _ = rsp.Write(data)
+	EnvelopeErrorEncoder(DefaultEnvelope)(req, rsp, err)
+}
+
+// EnvelopeErrorEncoder 按Accept协商的codec编码env封装后的错误, codec无法编码时退回json
+func EnvelopeErrorEncoder(env Envelope) func(*http.Request, http.ResponseWriter, error) {
+	return func(req *http.Request, rsp http.ResponseWriter, err error) {
+		codec, _ := Codec(req, utils.Accept)
+		code, data, e := encodeError(env, codec, req, err)
+		if e != nil {
+			codec = encoding.GetCodec(json.Name)
+			code, data, _ = encodeError(env, codec, req, err)
+		}
+		rsp.Header().Set(utils.ContentType, SetContentType(codec.Name()))
+		rsp.WriteHeader(code)
+		_, _ = rsp.Write(data)
+	}
+}
+
+// ErrorDecoder 解析ErrorEncoder编码的错误响应, 还原reason / metadata / details
+func ErrorDecoder(_ context.Context, rsp *http.Response) error {
+	if rsp.StatusCode >= http.StatusOK && rsp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return errors.New(rsp.StatusCode, errors.UnknownReason, errors.UnknownReason).WithError(err)
+	}
+	if e := decodeError(responseCodec(rsp), body); e != nil {
+		return e
+	}
+	return errors.New(rsp.StatusCode, errors.UnknownReason, errors.UnknownReason).WithReason(string(body))
 }
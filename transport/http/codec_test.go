@@ -0,0 +1,28 @@
+package http
+
+import (
+	"context"
+	"github.com/go-slark/slark/errors"
+	utils "github.com/go-slark/slark/pkg"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestErrorCodec(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(utils.AcceptLanguage, "en")
+	rec := httptest.NewRecorder()
+	ErrorEncoder(req, rec, errors.BadRequest("invalid param", "ERR_USER_PARAM").
+		WithMetadata(map[string]string{"uid": "1"}).
+		WithFieldViolation("name", "required"))
+
+	err := ErrorDecoder(context.Background(), rec.Result())
+	e := errors.FromError(err)
+	if e.Code != http.StatusBadRequest || e.Reason != "ERR_USER_PARAM" || e.Metadata["uid"] != "1" {
+		t.Fatalf("unexpected error %v", e)
+	}
+	if fv := errors.FieldViolations(e); len(fv) != 1 || fv[0].Field != "name" {
+		t.Fatalf("unexpected violations %v", fv)
+	}
+}
@@ -27,6 +27,15 @@ func Engine(cfg *EngineConfig) ServerOption {
 }
 
 func HandleMiddlewares(mw ...middleware.Middleware) gin.HandlerFunc {
+	return handleMiddlewares(ErrorEncoder, mw...)
+}
+
+// HandleMiddlewares 错误由server配置的errorEncoder写出
+func (s *Server) HandleMiddlewares(mw ...middleware.Middleware) gin.HandlerFunc {
+	return handleMiddlewares(s.codecs.errorEncoder, mw...)
+}
+
+func handleMiddlewares(ee func(*http.Request, http.ResponseWriter, error), mw ...middleware.Middleware) gin.HandlerFunc {
 	middle := middleware.ComposeMiddleware(mw...)
 	return func(ctx *gin.Context) {
 		reqCtx := ctx.Request.Context()
@@ -45,12 +54,10 @@ func HandleMiddlewares(mw ...middleware.Middleware) gin.HandlerFunc {
 			if e.Message != errors.Panic {
 				_ = ctx.Error(err)
 			}
-			rsp := &Response{
-				Header: &Header{},
+			// 后续handler已写出响应时不再覆盖
+			if !ctx.Writer.Written() {
+				ee(ctx.Request, ctx.Writer, err)
 			}
-			rsp.Code = int(e.Status.Code)
-			rsp.Msg = e.Status.Message
-			ctx.JSON(http.StatusOK, rsp)
 		}
 	}
 }
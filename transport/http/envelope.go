@@ -0,0 +1,397 @@
+package http
+
+import (
+	stdjson "encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/encoding/json"
+	protocodec "github.com/go-slark/slark/encoding/proto"
+	xmlcodec "github.com/go-slark/slark/encoding/xml"
+	"github.com/go-slark/slark/errors"
+	utils "github.com/go-slark/slark/pkg"
+	"github.com/go-slark/slark/transport/http/envelope"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const success = "成功"
+
+// Envelope 响应封装格式, 返回值由Accept协商的codec编码
+type Envelope interface {
+	Success(codec encoding.Codec, v interface{}) (interface{}, error)
+	Failure(codec encoding.Codec, e *errors.Error) (interface{}, error)
+}
+
+var (
+	// DefaultEnvelope {code,msg,data}, proto codec下为envelope.Response / envelope.Error
+	DefaultEnvelope Envelope = codeEnvelope{}
+	// RawEnvelope 成功响应不封装, 错误响应同DefaultEnvelope
+	RawEnvelope Envelope = rawEnvelope{}
+)
+
+// ResponseEnvelope 响应封装格式, 未通过RspCodec / ErrorCodec自定义时生效, 流式响应同样适用
+func ResponseEnvelope(env Envelope) ServerOption {
+	return func(server *Server) {
+		server.envelope = env
+	}
+}
+
+type codeEnvelope struct{}
+
+func (codeEnvelope) Success(codec encoding.Codec, v interface{}) (interface{}, error) {
+	switch codec.Name() {
+	case protocodec.Name:
+		rsp := &envelope.Response{Msg: success}
+		if v == nil {
+			return rsp, nil
+		}
+		m, err := message(v)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Data, err = anypb.New(m)
+		return rsp, err
+	case json.Name:
+		data, err := codec.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return &Response{Header: &Header{Msg: success}, Data: stdjson.RawMessage(data)}, nil
+	default:
+		data, err := plain(v)
+		if err != nil {
+			return nil, err
+		}
+		return &Response{Header: &Header{Msg: success}, Data: data}, nil
+	}
+}
+
+func (codeEnvelope) Failure(codec encoding.Codec, e *errors.Error) (interface{}, error) {
+	if codec.Name() == protocodec.Name {
+		rsp := &envelope.Error{
+			Code:     e.Code,
+			Msg:      e.Message,
+			Reason:   e.Reason,
+			Metadata: e.Metadata,
+		}
+		for _, detail := range e.Details() {
+			d, err := anypb.New(detail)
+			if err != nil {
+				return nil, err
+			}
+			rsp.Details = append(rsp.Details, d)
+		}
+		return rsp, nil
+	}
+	details, err := errors.EncodeDetails(e.Details())
+	if err != nil {
+		return nil, err
+	}
+	return &ErrorResponse{
+		Header: &Header{
+			Code: int(e.Code),
+			Msg:  e.Message,
+		},
+		Reason:   e.Reason,
+		Metadata: e.Metadata,
+		Details:  details,
+	}, nil
+}
+
+type rawEnvelope struct{}
+
+func (rawEnvelope) Success(codec encoding.Codec, v interface{}) (interface{}, error) {
+	switch codec.Name() {
+	case protocodec.Name:
+		if v == nil {
+			return &emptypb.Empty{}, nil
+		}
+		return message(v)
+	case json.Name:
+		return v, nil
+	case xmlcodec.Name:
+		data, err := plain(v)
+		return &xmlValue{data}, err
+	default:
+		return plain(v)
+	}
+}
+
+func (rawEnvelope) Failure(codec encoding.Codec, e *errors.Error) (interface{}, error) {
+	return codeEnvelope{}.Failure(codec, e)
+}
+
+func message(v interface{}) (proto.Message, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("proto codec requires proto.Message, got %T", v)
+	}
+	return m, nil
+}
+
+// plain 将proto消息按protojson转换为通用值, 使xml / yaml / msgpack等codec与json字段一致
+func plain(v interface{}) (interface{}, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return v, nil
+	}
+	data, err := json.MarshalOptions.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = stdjson.Unmarshal(data, &out)
+	return out, err
+}
+
+func encodeResponse(env Envelope, codec encoding.Codec, v interface{}) ([]byte, error) {
+	rsp, err := env.Success(codec, v)
+	if err != nil {
+		return nil, err
+	}
+	return codec.Marshal(rsp)
+}
+
+// encodeError 本地化错误后按env编码, 返回HTTP状态码
+func encodeError(env Envelope, codec encoding.Codec, req *http.Request, err error) (int, []byte, error) {
+	e := errors.GetCatalog().Localize(errors.FromError(err), req.Header.Get(utils.AcceptLanguage))
+	rsp, err := env.Failure(codec, e)
+	if err != nil {
+		return int(e.Code), nil, err
+	}
+	data, err := codec.Marshal(rsp)
+	return int(e.Code), data, err
+}
+
+// decodeResponse 解码DefaultEnvelope封装的成功响应, code非0时返回errors.Error
+func decodeResponse(codec encoding.Codec, body []byte, v interface{}) error {
+	switch codec.Name() {
+	case protocodec.Name:
+		rsp := &envelope.Response{}
+		if err := codec.Unmarshal(body, rsp); err != nil {
+			return errors.InternalServer("decode response envelope", err.Error())
+		}
+		if rsp.Code != 0 {
+			return errors.New(int(rsp.Code), rsp.Msg, errors.UnknownReason)
+		}
+		if v == nil || rsp.Data == nil {
+			return nil
+		}
+		m, err := message(v)
+		if err != nil {
+			return err
+		}
+		return rsp.Data.UnmarshalTo(m)
+	case json.Name:
+		rsp := &struct {
+			*Header
+			Data stdjson.RawMessage `json:"data"`
+		}{Header: &Header{}}
+		if err := stdjson.Unmarshal(body, rsp); err != nil {
+			return errors.InternalServer("decode response envelope", err.Error())
+		}
+		if rsp.Code != 0 {
+			return errors.New(rsp.Code, rsp.Msg, errors.UnknownReason)
+		}
+		if v == nil || len(rsp.Data) == 0 || string(rsp.Data) == "null" {
+			return nil
+		}
+		return codec.Unmarshal(rsp.Data, v)
+	default:
+		rsp := map[string]interface{}{}
+		if err := codec.Unmarshal(body, &rsp); err != nil {
+			return errors.InternalServer("decode response envelope", err.Error())
+		}
+		if code := number(rsp["code"]); code != 0 {
+			return errors.New(code, fmt.Sprint(rsp["msg"]), errors.UnknownReason)
+		}
+		if v == nil || rsp["data"] == nil {
+			return nil
+		}
+		data, err := stdjson.Marshal(rsp["data"])
+		if err != nil {
+			return errors.InternalServer("decode response envelope", err.Error())
+		}
+		return encoding.GetCodec(json.Name).Unmarshal(data, v)
+	}
+}
+
+// decodeError 解码Failure封装的错误响应, 非错误响应返回nil
+func decodeError(codec encoding.Codec, body []byte) *errors.Error {
+	switch codec.Name() {
+	case protocodec.Name:
+		rsp := &envelope.Error{}
+		if codec.Unmarshal(body, rsp) != nil || rsp.Code == 0 {
+			return nil
+		}
+		details := make([]proto.Message, 0, len(rsp.Details))
+		for _, d := range rsp.Details {
+			if m, err := d.UnmarshalNew(); err == nil {
+				details = append(details, m)
+			}
+		}
+		return errors.New(int(rsp.Code), rsp.Msg, rsp.Reason).WithMetadata(rsp.Metadata).WithDetails(details...)
+	case json.Name:
+		rsp := &ErrorResponse{Header: &Header{}}
+		if codec.Unmarshal(body, rsp) != nil || rsp.Code == 0 {
+			return nil
+		}
+		return errors.New(rsp.Code, rsp.Msg, rsp.Reason).WithMetadata(rsp.Metadata).WithDetails(errors.DecodeDetails(rsp.Details)...)
+	default:
+		rsp := map[string]interface{}{}
+		if codec.Unmarshal(body, &rsp) != nil {
+			return nil
+		}
+		code := number(rsp["code"])
+		if code == 0 {
+			return nil
+		}
+		md := map[string]string{}
+		if m, ok := rsp["metadata"].(map[string]interface{}); ok {
+			for k, v := range m {
+				md[k] = fmt.Sprint(v)
+			}
+		}
+		var details []map[string]any
+		switch d := rsp["details"].(type) {
+		case map[string]interface{}:
+			details = append(details, d)
+		case []interface{}:
+			for _, item := range d {
+				if m, ok := item.(map[string]interface{}); ok {
+					details = append(details, m)
+				}
+			}
+		}
+		reason, _ := rsp["reason"].(string)
+		msg, _ := rsp["msg"].(string)
+		return errors.New(code, msg, reason).WithMetadata(md).WithDetails(errors.DecodeDetails(details)...)
+	}
+}
+
+func number(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case int8:
+		return int(n)
+	case int16:
+		return int(n)
+	case int32:
+		return int(n)
+	case int64:
+		return int(n)
+	case uint8:
+		return int(n)
+	case uint16:
+		return int(n)
+	case uint32:
+		return int(n)
+	case uint64:
+		return int(n)
+	case float64:
+		return int(n)
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	default:
+		return 0
+	}
+}
+
+func (r *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	h := r.Header
+	if h == nil {
+		h = &Header{}
+	}
+	start.Name = xml.Name{Local: "response"}
+	return encodeXMLFields(e, start, xmlField{"code", h.Code}, xmlField{"msg", h.Msg}, xmlField{"data", r.Data})
+}
+
+func (r *ErrorResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	h := r.Header
+	if h == nil {
+		h = &Header{}
+	}
+	start.Name = xml.Name{Local: "error"}
+	fields := []xmlField{{"code", h.Code}, {"msg", h.Msg}, {"reason", r.Reason}}
+	if len(r.Metadata) != 0 {
+		fields = append(fields, xmlField{"metadata", r.Metadata})
+	}
+	for _, d := range r.Details {
+		fields = append(fields, xmlField{"details", d})
+	}
+	fields = append(fields, xmlField{"data", r.Data})
+	return encodeXMLFields(e, start, fields...)
+}
+
+// xmlValue 未封装响应的xml根元素
+type xmlValue struct {
+	v interface{}
+}
+
+func (x *xmlValue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "response"}
+	return encodeXML(e, start, x.v)
+}
+
+type xmlField struct {
+	name  string
+	value interface{}
+}
+
+func encodeXMLFields(e *xml.Encoder, start xml.StartElement, fields ...xmlField) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if err := encodeXML(e, xml.StartElement{Name: xml.Name{Local: f.name}}, f.value); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeXML 编码通用值: map的key排序后作为子元素, "@"开头的key作为属性, 列表展开为同名元素
+func encodeXML(e *xml.Encoder, start xml.StartElement, v interface{}) error {
+	switch x := v.(type) {
+	case nil:
+		return e.EncodeElement("", start)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		fields := make([]xmlField, 0, len(keys))
+		for _, k := range keys {
+			if strings.HasPrefix(k, "@") {
+				start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: k[1:]}, Value: fmt.Sprint(x[k])})
+				continue
+			}
+			fields = append(fields, xmlField{k, x[k]})
+		}
+		return encodeXMLFields(e, start, fields...)
+	case map[string]string:
+		m := make(map[string]interface{}, len(x))
+		for k, s := range x {
+			m[k] = s
+		}
+		return encodeXML(e, start, m)
+	case []interface{}:
+		for _, item := range x {
+			if err := encodeXML(e, start, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return e.EncodeElement(v, start)
+	}
+}
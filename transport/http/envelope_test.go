@@ -0,0 +1,87 @@
+package http
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	_ "github.com/go-slark/slark/encoding/msgpack"
+	_ "github.com/go-slark/slark/encoding/xml"
+	_ "github.com/go-slark/slark/encoding/yaml"
+	"github.com/go-slark/slark/errors"
+	"github.com/go-slark/slark/middleware"
+	utils "github.com/go-slark/slark/pkg"
+	"google.golang.org/protobuf/proto"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEnvelope(t *testing.T) {
+	in := &errors.Status{Code: 1, Reason: "r", Message: "m", Metadata: map[string]string{"k": "v"}}
+	for _, codec := range []string{"json", "proto", "xml", "yaml", "msgpack"} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(utils.Accept, SetContentType(codec))
+		rec := httptest.NewRecorder()
+		if err := ResponseEncoder(req, rec, in); err != nil {
+			t.Fatalf("%s encode: %v", codec, err)
+		}
+		if rec.Header().Get(utils.ContentType) != SetContentType(codec) {
+			t.Fatalf("%s unexpected content type %s", codec, rec.Header().Get(utils.ContentType))
+		}
+		out := &errors.Status{}
+		if err := EnvelopeDecoder(context.Background(), rec.Result(), out); err != nil || !proto.Equal(in, out) {
+			t.Fatalf("%s decode %v %v", codec, out, err)
+		}
+
+		rec = httptest.NewRecorder()
+		ErrorEncoder(req, rec, errors.NotFound("not found", "NOT_FOUND").WithMetadata(map[string]string{"id": "1"}).WithFieldViolation("id", "missing"))
+		e := errors.FromError(ErrorDecoder(context.Background(), rec.Result()))
+		if rec.Code != http.StatusNotFound || e.Code != http.StatusNotFound || e.Reason != "NOT_FOUND" || e.Metadata["id"] != "1" {
+			t.Fatalf("%s unexpected error %d %v", codec, rec.Code, e)
+		}
+		// xml将@type编码为属性且无法区分单元素列表, details仅保证其他codec还原
+		if codec != "xml" && len(errors.FieldViolations(e)) != 1 {
+			t.Fatalf("%s unexpected error %d %v", codec, rec.Code, e)
+		}
+
+		rec = httptest.NewRecorder()
+		if err := EnvelopeEncoder(RawEnvelope)(req, rec, in); err != nil {
+			t.Fatalf("%s raw encode: %v", codec, err)
+		}
+		out = &errors.Status{}
+		if codec == "json" || codec == "proto" {
+			if err := RawDecoder(context.Background(), rec.Result(), out); err != nil || !proto.Equal(in, out) {
+				t.Fatalf("%s raw decode %v %v", codec, out, err)
+			}
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(utils.Accept, SetContentType("xml"))
+	rec := httptest.NewRecorder()
+	_ = ResponseEncoder(req, rec, in)
+	want := `<response><code>0</code><msg>成功</msg><data><code>1</code><message>m</message><metadata><k>v</k></metadata><reason>r</reason></data></response>`
+	if rec.Body.String() != want {
+		t.Fatalf("unexpected xml %s", rec.Body.String())
+	}
+}
+
+func TestHandleMiddlewares(t *testing.T) {
+	srv := NewServer(Address("127.0.0.1:0"), ResponseEnvelope(RawEnvelope))
+	defer srv.listener.Close()
+	engine := gin.New()
+	engine.Use(srv.HandleMiddlewares(func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errors.Unauthorized("unauthorized", "TOKEN_ERROR")
+		}
+	}))
+	engine.GET("/", func(ctx *gin.Context) {})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(utils.Accept, SetContentType("proto"))
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	e := errors.FromError(ErrorDecoder(context.Background(), rec.Result()))
+	if rec.Code != http.StatusUnauthorized || e.Reason != "TOKEN_ERROR" {
+		t.Fatalf("unexpected error %d %v", rec.Code, e)
+	}
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// OpenAPI fsys中的*.json / *.yaml文档(如protoc-gen-http --http_opt=openapi=true生成)在{BasePath}/doc/openapi/下,
+// 与Engine的FileSystem同时使用时路由冲突
+func OpenAPI(fsys fs.FS) ServerOption {
+	return func(server *Server) {
+		server.openapi = fsys
+	}
+}
+
+// SwaggerUI {BasePath}/doc/提供swagger ui, 需同时设置OpenAPI, 如SwaggerUI(swaggerui.Dist)
+func SwaggerUI(dist fs.FS) ServerOption {
+	return func(server *Server) {
+		server.swaggerUI = dist
+	}
+}
+
+type spec struct {
+	URL  string `json:"url"`
+	Name string `json:"name"`
+}
+
+func (s *Server) registerOpenAPI() {
+	var specs []spec
+	_ = fs.WalkDir(s.openapi, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		switch path.Ext(name) {
+		case ".json", ".yaml", ".yml":
+			specs = append(specs, spec{
+				URL:  "openapi/" + name,
+				Name: strings.TrimSuffix(strings.TrimSuffix(name, path.Ext(name)), ".openapi"),
+			})
+		}
+		return nil
+	})
+	urls, _ := json.Marshal(specs)
+	initializer := []byte(fmt.Sprintf(swaggerInitializer, urls))
+
+	prefix := path.Join(s.basePath, "doc")
+	var assets http.Handler = http.NotFoundHandler()
+	if s.swaggerUI != nil {
+		assets = http.StripPrefix(prefix, http.FileServer(http.FS(s.swaggerUI)))
+	}
+	docs := http.StripPrefix(path.Join(prefix, "openapi"), http.FileServer(http.FS(s.openapi)))
+	s.engine.GET(prefix+"/*filepath", func(ctx *gin.Context) {
+		name := ctx.Param("filepath")
+		switch {
+		case name == "/swagger-initializer.js":
+			ctx.Data(http.StatusOK, "text/javascript; charset=utf-8", initializer)
+		case strings.HasPrefix(name, "/openapi/"):
+			docs.ServeHTTP(ctx.Writer, ctx.Request)
+		default:
+			assets.ServeHTTP(ctx.Writer, ctx.Request)
+		}
+	})
+}
+
+const swaggerInitializer = `window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    urls: %s,
+    dom_id: '#swagger-ui',
+    deepLinking: true,
+    presets: [
+      SwaggerUIBundle.presets.apis,
+      SwaggerUIStandalonePreset
+    ],
+    plugins: [
+      SwaggerUIBundle.plugins.DownloadUrl
+    ],
+    layout: "StandaloneLayout"
+  });
+};
+`
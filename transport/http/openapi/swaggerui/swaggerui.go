@@ -0,0 +1,14 @@
+// Package swaggerui 内置swagger ui静态文件(约1.5MB), 按需引入: http.SwaggerUI(swaggerui.Dist)
+package swaggerui
+
+import (
+	swagger "github.com/go-slark/slark/third_party/swagger_ui"
+	"io/fs"
+)
+
+// Dist swagger ui静态文件根目录, swagger-initializer.js由transport/http按文档列表生成
+var Dist fs.FS
+
+func init() {
+	Dist, _ = fs.Sub(swagger.Dist, "dist")
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"github.com/go-slark/slark/transport/http/openapi/swaggerui"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestOpenAPI(t *testing.T) {
+	doc := `{"openapi":"3.1.0","info":{"title":"user.v1","version":"0.0.1"},"paths":{}}`
+	srv := NewServer(Address("127.0.0.1:0"), BasePath("/api"), OpenAPI(fstest.MapFS{
+		"user/v1/user.openapi.json": {Data: []byte(doc)},
+		"README.md":                 {Data: []byte("readme")},
+	}), SwaggerUI(swaggerui.Dist))
+	defer srv.listener.Close()
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		srv.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+	if rec := get("/api/doc/"); rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "swagger-ui") {
+		t.Fatalf("unexpected ui %d", rec.Code)
+	}
+	if rec := get("/api/doc/swagger-initializer.js"); !strings.Contains(rec.Body.String(), `[{"url":"openapi/user/v1/user.openapi.json","name":"user/v1/user"}]`) {
+		t.Fatalf("unexpected initializer %s", rec.Body.String())
+	}
+	if rec := get("/api/doc/openapi/user/v1/user.openapi.json"); rec.Body.String() != doc {
+		t.Fatalf("unexpected doc %s", rec.Body.String())
+	}
+	if rec := get("/api/doc/swagger-ui-bundle.js"); rec.Code != http.StatusOK {
+		t.Fatalf("unexpected asset %d", rec.Code)
+	}
+}
+
+func TestOpenAPIWithoutUI(t *testing.T) {
+	srv := NewServer(Address("127.0.0.1:0"), OpenAPI(fstest.MapFS{
+		"user.openapi.json": {Data: []byte("{}")},
+	}))
+	defer srv.listener.Close()
+
+	rec := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/doc/openapi/user.openapi.json", nil))
+	if rec.Body.String() != "{}" {
+		t.Fatalf("unexpected doc %s", rec.Body.String())
+	}
+	rec = httptest.NewRecorder()
+	srv.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/doc/swagger-ui-bundle.js", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("swagger ui served without SwaggerUI option %d", rec.Code)
+	}
+}
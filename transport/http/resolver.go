@@ -0,0 +1,137 @@
+package http
+
+import (
+	"context"
+	"errors"
+	utils "github.com/go-slark/slark/pkg"
+	"github.com/go-slark/slark/pkg/endpoint"
+	"github.com/go-slark/slark/pkg/retry"
+	"github.com/go-slark/slark/registry"
+	"github.com/go-slark/slark/transport/grpc/balancer/node"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// resolver 基于registry.Discovery维护可用节点, 由node.Balancer选取
+type resolver struct {
+	l        sync.Mutex
+	watcher  registry.Watcher
+	balancer node.Balancer
+	ctx      context.Context
+	cancel   context.CancelFunc
+	ready    chan struct{}
+	once     sync.Once
+	err      error
+	insecure bool
+}
+
+func newResolver(dis registry.Discovery, name string, builder node.Builder, insecure bool) *resolver {
+	r := &resolver{
+		balancer: builder.Build(),
+		ready:    make(chan struct{}),
+		insecure: insecure,
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	go func() {
+		watcher, err := dis.Discover(r.ctx, name)
+		if err != nil {
+			r.err = err
+			r.once.Do(func() {
+				close(r.ready)
+			})
+			return
+		}
+		r.l.Lock()
+		if r.ctx.Err() != nil {
+			// Discover期间已close
+			r.l.Unlock()
+			_ = watcher.Stop()
+			return
+		}
+		r.watcher = watcher
+		r.l.Unlock()
+		r.watch(watcher)
+	}()
+	return r
+}
+
+func (r *resolver) watch(watcher registry.Watcher) {
+	var failures int
+	backoff := retry.NewOption(retry.Delay(100*time.Millisecond), retry.MaxJitter(100*time.Millisecond))
+	for {
+		snapshot, err := watcher.Next(r.ctx)
+		if err != nil {
+			if r.ctx.Err() != nil || errors.Is(err, context.Canceled) {
+				return
+			}
+			failures++
+			d := retry.Group(retry.BackOff, retry.Random)(failures, backoff)
+			if d > 10*time.Second {
+				d = 10 * time.Second
+			}
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-time.After(d):
+			}
+			continue
+		}
+		failures = 0
+		r.update(snapshot.Services)
+	}
+}
+
+func (r *resolver) update(svc []*registry.Service) {
+	mp := map[string]struct{}{}
+	nodes := make([]node.Node, 0, len(svc))
+	for _, s := range svc {
+		addr, err := endpoint.ParseValidAddr(s.Endpoint, endpoint.Scheme("http", r.insecure))
+		if err != nil {
+			continue
+		}
+		if _, ok := mp[addr]; ok {
+			continue
+		}
+		mp[addr] = struct{}{}
+		n := &node.WrappedNode{Addr: addr}
+		w, ok := s.Metadata[utils.Weight]
+		if ok {
+			weight, e := strconv.ParseInt(w, 10, 64)
+			if e == nil {
+				n.Weight = &weight
+			}
+		}
+		nodes = append(nodes, n)
+	}
+	// 空列表保护: 保留原有节点
+	if len(nodes) == 0 {
+		return
+	}
+	r.balancer.Save(nodes)
+	r.once.Do(func() {
+		close(r.ready)
+	})
+}
+
+func (r *resolver) pick(ctx context.Context, filters ...node.Filter) (node.Node, error) {
+	select {
+	case <-r.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.balancer.Pick(ctx, filters...)
+}
+
+func (r *resolver) close() error {
+	r.l.Lock()
+	defer r.l.Unlock()
+	r.cancel()
+	if r.watcher != nil {
+		return r.watcher.Stop()
+	}
+	return nil
+}
@@ -3,20 +3,25 @@ package http
 import (
 	"context"
 	"github.com/gin-gonic/gin"
+	"github.com/go-slark/slark/errors"
 	utils "github.com/go-slark/slark/pkg"
 	"github.com/go-slark/slark/transport/http/handler"
 	"net/http"
+	"strings"
 	"sync"
 )
 
 type Router struct {
 	pool sync.Pool
 	srv  *Server
+	// method+gin路由 -> 自定义方法 -> handler, 同一gin路由只注册一次
+	verbs map[string]map[string]gin.HandlerFunc
 }
 
 func NewRouter(srv *Server) *Router {
 	router := &Router{
-		srv: srv,
+		srv:   srv,
+		verbs: make(map[string]map[string]gin.HandlerFunc),
 	}
 	router.pool.New = func() any {
 		return &Context{
@@ -30,13 +35,10 @@ func NewRouter(srv *Server) *Router {
 type HandlerFunc func(ctx *Context) error
 
 func (r *Router) Handle(method, path string, hf HandlerFunc, handlers ...handler.Middleware) {
+	// /uri/:name/:id 或 /v1/{name=shelves/*} 或 /v1/users/{id}:watch
+	path, verb, vars := route(path)
 	h := func(ctx *gin.Context) {
-		// /uri/:name/:id
-		mp := make(map[string]string, len(ctx.Params))
-		for _, param := range ctx.Params {
-			mp[param.Key] = param.Value
-		}
-		ctx.Request = ctx.Request.WithContext(context.WithValue(ctx.Request.Context(), utils.RequestVars, mp))
+		ctx.Request = ctx.Request.WithContext(context.WithValue(ctx.Request.Context(), utils.RequestVars, vars(ctx)))
 		handler.ComposeMiddleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 			c := r.pool.Get().(*Context)
 			c.Set(req, w)
@@ -47,5 +49,34 @@ func (r *Router) Handle(method, path string, hf HandlerFunc, handlers ...handler
 			r.pool.Put(c)
 		}), handlers...).ServeHTTP(ctx.Writer, ctx.Request)
 	}
-	r.srv.engine.Handle(method, r.srv.basePath+path, h)
+	path = r.srv.basePath + path
+	key := method + " " + path
+	if verbs, ok := r.verbs[key]; ok {
+		verbs[verb] = h
+		return
+	}
+	r.verbs[key] = map[string]gin.HandlerFunc{verb: h}
+	r.srv.engine.Handle(method, path, r.dispatch(r.verbs[key]))
+}
+
+// dispatch 按最后一个path参数的:verb后缀选择handler, 未注册的后缀视为参数值的一部分
+func (r *Router) dispatch(verbs map[string]gin.HandlerFunc) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		verb := ""
+		if n := len(ctx.Params); n > 0 {
+			last := &ctx.Params[n-1]
+			if i := strings.LastIndexByte(last.Value, ':'); i >= 0 {
+				if _, ok := verbs[last.Value[i+1:]]; ok {
+					verb = last.Value[i+1:]
+					last.Value = last.Value[:i]
+				}
+			}
+		}
+		h, ok := verbs[verb]
+		if !ok {
+			r.srv.codecs.errorEncoder(ctx.Request, ctx.Writer, errors.NotFound("route not found", errors.UnknownReason))
+			return
+		}
+		h(ctx)
+	}
 }
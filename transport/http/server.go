@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/go-slark/slark/health"
 	"github.com/go-slark/slark/logger"
 	"github.com/go-slark/slark/middleware"
 	"github.com/go-slark/slark/middleware/flexible/breaker"
@@ -20,14 +21,18 @@ import (
 	"github.com/go-slark/slark/transport"
 	"github.com/go-slark/slark/transport/http/handler"
 	"go.opentelemetry.io/otel/trace"
+	"io/fs"
 	"net"
 	"net/http"
 	"net/url"
+	"sync"
 )
 
 type Server struct {
 	*http.Server
 	listener net.Listener
+	ready    chan struct{}
+	once     sync.Once
 	tls      *tls.Config
 	handlers []handler.Middleware
 	mws      []middleware.Middleware
@@ -40,6 +45,15 @@ type Server struct {
 	logger   logger.Logger
 	codecs   *Codecs
 	headers  []string
+	health   *health.Health
+	openapi  fs.FS
+	upgrader Upgrader
+	envelope Envelope
+	// swagger ui静态文件, 未设置时只提供文档
+	swaggerUI fs.FS
+	// 存活/就绪路由
+	liveness  string
+	readiness string
 }
 
 type ServerOption func(server *Server)
@@ -110,6 +124,21 @@ func RspCodec(rc func(*http.Request, http.ResponseWriter, interface{}) error) Se
 	}
 }
 
+// Health 探针集合, 默认health.GetHealth(), 为nil时不注册存活/就绪路由
+func Health(h *health.Health) ServerOption {
+	return func(server *Server) {
+		server.health = h
+	}
+}
+
+// HealthPath 存活/就绪路由, 默认/healthz /readyz, 为空时不注册对应路由
+func HealthPath(liveness, readiness string) ServerOption {
+	return func(server *Server) {
+		server.liveness = liveness
+		server.readiness = readiness
+	}
+}
+
 func Headers(headers []string) ServerOption {
 	return func(server *Server) {
 		server.headers = headers
@@ -118,24 +147,30 @@ func Headers(headers []string) ServerOption {
 
 func NewServer(opts ...ServerOption) *Server {
 	engine := gin.New()
+	// 单段path参数中的'/'由EncodeURL编码为%2F, 按原始路径匹配路由后再解码参数
+	engine.UseRawPath = true
+	engine.UnescapePathValues = true
 	srv := &Server{
 		network:  "tcp",
 		address:  "0.0.0.0:8080",
 		basePath: "/",
 		logger:   logger.GetLogger(),
 		Server:   &http.Server{},
+		ready:    make(chan struct{}),
 		handlers: []handler.Middleware{handler.CORS()},
 		engine:   engine,
 		codecs: &Codecs{
 			bodyDecoder:  RequestBodyDecoder,
 			varsDecoder:  RequestVarsDecoder,
 			queryDecoder: RequestQueryDecoder,
-			rspEncoder:   ResponseEncoder,
-			errorEncoder: ErrorEncoder,
 		},
-		headers: []string{utils.Token, utils.Authorization, utils.UserAgent, utils.XForwardedMethod, utils.XForwardedIP, utils.XForwardedURI, utils.Extension},
-		mws:     []middleware.Middleware{},
-		enable:  0x63, // low -> high
+		envelope:  DefaultEnvelope,
+		health:    health.GetHealth(),
+		liveness:  health.LivenessPath,
+		readiness: health.ReadinessPath,
+		headers:   []string{utils.Token, utils.Authorization, utils.UserAgent, utils.XForwardedMethod, utils.XForwardedIP, utils.XForwardedURI, utils.Extension},
+		mws:       []middleware.Middleware{},
+		enable:    0x63, // low -> high
 	}
 	srv.mws = []middleware.Middleware{
 		tracing.Trace(trace.SpanKindServer),
@@ -150,6 +185,21 @@ func NewServer(opts ...ServerOption) *Server {
 		o(srv)
 	}
 	srv.mws = utils.Filter(srv.mws, srv.enable)
+	if srv.codecs.rspEncoder == nil {
+		srv.codecs.rspEncoder = EnvelopeEncoder(srv.envelope)
+	}
+	if srv.codecs.errorEncoder == nil {
+		srv.codecs.errorEncoder = EnvelopeErrorEncoder(srv.envelope)
+	}
+	if srv.health != nil && len(srv.liveness) > 0 {
+		srv.engine.GET(srv.liveness, gin.WrapH(srv.health.LivenessHandler()))
+	}
+	if srv.health != nil && len(srv.readiness) > 0 {
+		srv.engine.GET(srv.readiness, gin.WrapH(srv.health.ReadinessHandler()))
+	}
+	if srv.openapi != nil {
+		srv.registerOpenAPI()
+	}
 	srv.TLSConfig = srv.tls
 	srv.handlers = append(srv.handlers, func(handler http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -196,6 +246,9 @@ func (s *Server) Start() error {
 	if s.err != nil {
 		return s.err
 	}
+	s.once.Do(func() {
+		close(s.ready)
+	})
 	var err error
 	if s.tls != nil {
 		err = s.ServeTLS(s.listener, "", "")
@@ -208,6 +261,10 @@ func (s *Server) Start() error {
 	return nil
 }
 
+func (s *Server) Ready() <-chan struct{} {
+	return s.ready
+}
+
 func (s *Server) Stop(ctx context.Context) error {
 	return s.Shutdown(ctx)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/go-slark/slark/transport/http/handler"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -77,3 +78,28 @@ func TestURI(t *testing.T) {
 	})
 	srv.Start()
 }
+
+func TestHealthRoutes(t *testing.T) {
+	get := func(srv *Server, path string) int {
+		defer srv.listener.Close()
+		rec := httptest.NewRecorder()
+		srv.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Code
+	}
+	// 默认注册/healthz /readyz
+	if code := get(NewServer(Address("127.0.0.1:0")), "/healthz"); code != http.StatusOK {
+		t.Fatalf("default liveness %d", code)
+	}
+	if code := get(NewServer(Address("127.0.0.1:0")), "/readyz"); code == http.StatusNotFound {
+		t.Fatal("default readiness not registered")
+	}
+	if code := get(NewServer(Address("127.0.0.1:0"), HealthPath("/live", "")), "/live"); code != http.StatusOK {
+		t.Fatalf("custom liveness %d", code)
+	}
+	if code := get(NewServer(Address("127.0.0.1:0"), HealthPath("/live", "")), "/readyz"); code != http.StatusNotFound {
+		t.Fatalf("readiness disabled %d", code)
+	}
+	if code := get(NewServer(Address("127.0.0.1:0"), Health(nil)), "/healthz"); code != http.StatusNotFound {
+		t.Fatalf("health disabled %d", code)
+	}
+}
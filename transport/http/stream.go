@@ -0,0 +1,264 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/encoding/json"
+	"github.com/go-slark/slark/errors"
+	utils "github.com/go-slark/slark/pkg"
+	"github.com/go-slark/slark/pkg/routine"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	EventStream = "text/event-stream"
+	NDJSON      = "application/x-ndjson"
+)
+
+// Stream 流式RPC消息流, 消息按server的Envelope封装, 出错时以错误响应作为最后一帧
+type Stream interface {
+	Context() context.Context
+	SendMsg(m interface{}) error
+	RecvMsg(m interface{}) error
+}
+
+// StreamConn 双向流连接, 空消息表示客户端结束发送
+type StreamConn interface {
+	ReadMessage() ([]byte, error)
+	WriteMessage(data []byte, text bool) error
+	Done() <-chan struct{}
+	Close()
+}
+
+// Upgrader 将请求升级为双向流连接, 由transport/ws基于Session实现
+type Upgrader func(w http.ResponseWriter, r *http.Request) (StreamConn, error)
+
+// WebSocket 客户端流/双向流所需的连接升级, 如ws.NewUpgrader()
+func WebSocket(u Upgrader) ServerOption {
+	return func(server *Server) {
+		server.upgrader = u
+	}
+}
+
+type stream interface {
+	Stream
+	close(err error)
+}
+
+// ServerStream 服务端流: 按Accept以SSE(默认)或NDJSON逐条输出, WebSocket握手请求则升级为WebSocket
+func (c *Context) ServerStream(in interface{}, h func(req interface{}, stream Stream) error) error {
+	return c.stream(in, func(ctx context.Context) (stream, error) {
+		if isWebSocket(c.req) {
+			return c.upgrade(ctx)
+		}
+		return newEventStream(ctx, c.router.srv.envelope, c.req, c.rsp), nil
+	}, h)
+}
+
+// BidiStream 客户端流/双向流, 仅支持WebSocket
+func (c *Context) BidiStream(h func(stream Stream) error) error {
+	return c.stream(nil, func(ctx context.Context) (stream, error) {
+		if !isWebSocket(c.req) {
+			return nil, errors.BadRequest("websocket upgrade required", errors.HeaderError)
+		}
+		return c.upgrade(ctx)
+	}, func(_ interface{}, stream Stream) error {
+		return h(stream)
+	})
+}
+
+// stream 在中间件链内建立流, 建立前的错误交由errorEncoder, 建立后的错误写入终止帧
+func (c *Context) stream(in interface{}, open func(ctx context.Context) (stream, error), h func(req interface{}, stream Stream) error) error {
+	var s stream
+	_, err := c.Handle(func(ctx context.Context, req interface{}) (interface{}, error) {
+		var e error
+		s, e = open(ctx)
+		if e != nil {
+			return nil, e
+		}
+		return nil, h(req, s)
+	})(c.ctx, in)
+	if s == nil {
+		return err
+	}
+	s.close(err)
+	return nil
+}
+
+func (c *Context) upgrade(ctx context.Context) (stream, error) {
+	if c.router.srv.upgrader == nil {
+		return nil, errors.New(http.StatusNotImplemented, "websocket not supported", errors.UnknownReason)
+	}
+	conn, err := c.router.srv.upgrader(c.rsp, c.req)
+	if err != nil {
+		return nil, errors.BadRequest("websocket upgrade", errors.HeaderError).WithError(err)
+	}
+	return newWSStream(ctx, c.router.srv.envelope, c.req, conn), nil
+}
+
+func isWebSocket(req *http.Request) bool {
+	return strings.EqualFold(req.Header.Get("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(req.Header.Get("Connection")), "upgrade")
+}
+
+// eventStream text/event-stream 或 application/x-ndjson, 客户端断开时ctx取消
+type eventStream struct {
+	ctx   context.Context
+	env   Envelope
+	req   *http.Request
+	rsp   http.ResponseWriter
+	codec encoding.Codec
+	sse   bool
+}
+
+func newEventStream(ctx context.Context, env Envelope, req *http.Request, rsp http.ResponseWriter) *eventStream {
+	s := &eventStream{
+		ctx:   ctx,
+		env:   env,
+		req:   req,
+		rsp:   rsp,
+		codec: encoding.GetCodec(json.Name),
+		sse:   !strings.Contains(req.Header.Get(utils.Accept), NDJSON),
+	}
+	contentType := NDJSON
+	if s.sse {
+		contentType = EventStream
+	}
+	header := rsp.Header()
+	header.Set(utils.ContentType, contentType)
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no")
+	rsp.WriteHeader(http.StatusOK)
+	s.flush()
+	return s
+}
+
+func (s *eventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventStream) SendMsg(m interface{}) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	data, err := encodeResponse(s.env, s.codec, m)
+	if err != nil {
+		return err
+	}
+	return s.write("", data)
+}
+
+func (s *eventStream) RecvMsg(interface{}) error {
+	return io.EOF
+}
+
+func (s *eventStream) close(err error) {
+	if err == nil || s.ctx.Err() != nil {
+		return
+	}
+	_, data, _ := encodeError(s.env, s.codec, s.req, err)
+	_ = s.write("error", data)
+}
+
+func (s *eventStream) write(event string, data []byte) error {
+	buf := &bytes.Buffer{}
+	if s.sse {
+		if len(event) != 0 {
+			buf.WriteString("event: " + event + "\n")
+		}
+		for _, line := range bytes.Split(data, []byte("\n")) {
+			buf.WriteString("data: ")
+			buf.Write(line)
+			buf.WriteByte('\n')
+		}
+	} else {
+		buf.Write(data)
+	}
+	buf.WriteByte('\n')
+	_, err := s.rsp.Write(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	s.flush()
+	return nil
+}
+
+func (s *eventStream) flush() {
+	if f, ok := s.rsp.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// wsStream WebSocket流, 连接关闭时ctx取消
+type wsStream struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	env    Envelope
+	req    *http.Request
+	conn   StreamConn
+	in     encoding.Codec
+	out    encoding.Codec
+}
+
+func newWSStream(ctx context.Context, env Envelope, req *http.Request, conn StreamConn) *wsStream {
+	s := &wsStream{
+		env:  env,
+		req:  req,
+		conn: conn,
+	}
+	s.ctx, s.cancel = context.WithCancel(ctx)
+	s.in, _ = Codec(req, utils.ContentType)
+	s.out, _ = Codec(req, utils.Accept)
+	routine.GoSafe(context.TODO(), func() {
+		select {
+		case <-conn.Done():
+			s.cancel()
+		case <-s.ctx.Done():
+		}
+	})
+	return s
+}
+
+func (s *wsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *wsStream) SendMsg(m interface{}) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	data, err := encodeResponse(s.env, s.out, m)
+	if err != nil {
+		return err
+	}
+	return s.conn.WriteMessage(data, s.out.Name() == json.Name)
+}
+
+func (s *wsStream) RecvMsg(m interface{}) error {
+	data, err := s.conn.ReadMessage()
+	if err != nil {
+		// 连接已断开
+		s.cancel()
+		return err
+	}
+	if len(data) == 0 {
+		return io.EOF
+	}
+	err = s.in.Unmarshal(data, m)
+	if err != nil {
+		return errors.BadRequest("stream decoder", errors.FormatError).WithError(err)
+	}
+	return nil
+}
+
+func (s *wsStream) close(err error) {
+	if err != nil && err != io.EOF && s.ctx.Err() == nil {
+		_, data, _ := encodeError(s.env, s.out, s.req, err)
+		_ = s.conn.WriteMessage(data, s.out.Name() == json.Name)
+	}
+	s.cancel()
+	s.conn.Close()
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"context"
+	"github.com/go-slark/slark/errors"
+	"github.com/go-slark/slark/middleware"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type streamKey struct{}
+
+func TestServerStream(t *testing.T) {
+	srv := NewServer(Address("127.0.0.1:0"), Enable(1), Middlewares(func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if in, ok := req.(*errors.Status); ok && in.Code == http.StatusForbidden {
+				return nil, errors.Forbidden("forbidden", "FORBIDDEN")
+			}
+			return handler(context.WithValue(ctx, streamKey{}, "mw"), req)
+		}
+	}))
+	defer srv.listener.Close()
+	r := NewRouter(srv)
+	r.Handle(http.MethodGet, "/v1/status/{code}/watch", func(ctx *Context) error {
+		in := &errors.Status{}
+		if err := ctx.Bind(in, ""); err != nil {
+			return err
+		}
+		return ctx.ServerStream(in, func(req interface{}, stream Stream) error {
+			for i := 0; i < 2; i++ {
+				reason, _ := stream.Context().Value(streamKey{}).(string)
+				if err := stream.SendMsg(&errors.Status{Code: req.(*errors.Status).Code, Reason: reason}); err != nil {
+					return err
+				}
+			}
+			return errors.NotFound("done", "DONE")
+		})
+	})
+	r.Handle(http.MethodGet, "/v1/chat", func(ctx *Context) error {
+		return ctx.BidiStream(func(stream Stream) error {
+			return nil
+		})
+	})
+
+	do := func(path, accept string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept", accept)
+		rec := httptest.NewRecorder()
+		srv.Handler.ServeHTTP(rec, req)
+		return rec
+	}
+	frame := `{"code":0,"msg":"成功","data":{"code":200,"reason":"mw","message":"","metadata":{}}}`
+	rec := do("/v1/status/200/watch", "")
+	if rec.Header().Get("Content-Type") != EventStream {
+		t.Fatalf("unexpected content type %s", rec.Header().Get("Content-Type"))
+	}
+	want := "data: " + frame + "\n\ndata: " + frame + "\n\nevent: error\ndata: {\"code\":404,\"msg\":\"done\",\"reason\":\"DONE\",\"data\":null}\n\n"
+	if rec.Body.String() != want {
+		t.Fatalf("unexpected sse %q", rec.Body.String())
+	}
+
+	rec = do("/v1/status/200/watch", NDJSON)
+	if lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n"); len(lines) != 3 || lines[1] != frame || !strings.Contains(lines[2], `"reason":"DONE"`) {
+		t.Fatalf("unexpected ndjson %q", rec.Body.String())
+	}
+
+	// 建流前的错误仍按普通响应返回
+	if rec = do("/v1/status/403/watch", ""); rec.Code != http.StatusForbidden || !strings.Contains(rec.Body.String(), `"reason":"FORBIDDEN"`) {
+		t.Fatalf("unexpected rejection %d %s", rec.Code, rec.Body.String())
+	}
+	if rec = do("/v1/chat", ""); rec.Code != http.StatusBadRequest {
+		t.Fatalf("unexpected bidi without upgrade %d", rec.Code)
+	}
+}
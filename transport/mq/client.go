@@ -0,0 +1,117 @@
+package mq
+
+import (
+	"context"
+	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/logger"
+	"github.com/go-slark/slark/middleware"
+	"github.com/go-slark/slark/middleware/logging"
+	"github.com/go-slark/slark/middleware/metadata"
+	"github.com/go-slark/slark/middleware/metrics"
+	"github.com/go-slark/slark/middleware/recovery"
+	"github.com/go-slark/slark/middleware/tracing"
+	utils "github.com/go-slark/slark/pkg"
+	"github.com/go-slark/slark/transport"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Client 发布端, 消息经过middleware链后交给Publisher
+type Client struct {
+	pub    Publisher
+	codec  encoding.Codec
+	enable int64
+	logger logger.Logger
+	mws    []middleware.Middleware
+}
+
+type ClientOption func(*Client)
+
+// Codec 编码方式, 默认json
+func Codec(name string) ClientOption {
+	return func(c *Client) {
+		if codec := encoding.GetCodec(name); codec != nil {
+			c.codec = codec
+		}
+	}
+}
+
+func WithMiddleware(mws ...middleware.Middleware) ClientOption {
+	return func(c *Client) {
+		c.mws = mws
+	}
+}
+
+func WithLogger(l logger.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+func WithEnable(enable int64) ClientOption {
+	return func(c *Client) {
+		c.enable = enable
+	}
+}
+
+func NewClient(pub Publisher, opts ...ClientOption) *Client {
+	c := &Client{
+		pub:    pub,
+		codec:  encoding.GetCodec("json"),
+		enable: 0x1f,
+		logger: logger.GetLogger(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.mws == nil {
+		c.mws = []middleware.Middleware{
+			tracing.Trace(trace.SpanKindProducer),
+			metadata.Metadata(middleware.Client),
+			logging.Log(middleware.Client, c.logger),
+			metrics.Metrics(middleware.Client, metrics.WithCounter(metrics.RequestTotal)),
+			recovery.Recovery(c.logger),
+		}
+	}
+	c.mws = utils.Filter(c.mws, c.enable)
+	return c
+}
+
+// Send 编码v后发布, v为[]byte时不编码
+func (c *Client) Send(ctx context.Context, topic, key string, v interface{}) error {
+	msg := &Message{
+		Topic:  topic,
+		Key:    []byte(key),
+		Header: Header{},
+	}
+	if value, ok := v.([]byte); ok {
+		msg.Value = value
+	} else {
+		value, err := c.codec.Marshal(v)
+		if err != nil {
+			return err
+		}
+		msg.Value = value
+		msg.Header.Set(ContentType, c.codec.Name())
+	}
+	return c.Publish(ctx, msg)
+}
+
+func (c *Client) Publish(ctx context.Context, msg *Message) error {
+	if msg.Header == nil {
+		msg.Header = Header{}
+	}
+	trans := &Transport{
+		Topic: msg.Topic,
+		Req:   msg.Header,
+		Rsp:   Header{},
+	}
+	ctx = transport.NewClientContext(ctx, trans)
+	_, err := middleware.ComposeMiddleware(c.mws...)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, c.pub.Publish(ctx, msg)
+	})(ctx, msg)
+	return err
+}
+
+func (c *Client) Close() error {
+	return c.pub.Close()
+}
@@ -0,0 +1,128 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"github.com/go-slark/slark/transport/mq"
+	"sync"
+)
+
+var ErrClosed = errors.New("memory broker closed")
+
+// Broker 进程内消息队列, 用于测试及本地开发
+// 每次Subscribe独立接收订阅topic的全部消息, handler返回error时不重新投递
+type Broker struct {
+	l      sync.RWMutex
+	subs   map[string]map[*subscription]struct{}
+	size   int
+	closed bool
+	done   chan struct{}
+	once   sync.Once
+}
+
+type Option func(*Broker)
+
+// Size 每个订阅的缓冲大小
+func Size(size int) Option {
+	return func(b *Broker) {
+		if size > 0 {
+			b.size = size
+		}
+	}
+}
+
+func NewBroker(opts ...Option) *Broker {
+	b := &Broker{
+		subs: make(map[string]map[*subscription]struct{}),
+		size: 1024,
+		done: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+type subscription struct {
+	ch   chan *mq.Message
+	done chan struct{}
+}
+
+func (b *Broker) Publish(ctx context.Context, msg *mq.Message) error {
+	b.l.RLock()
+	defer b.l.RUnlock()
+	if b.closed {
+		return ErrClosed
+	}
+	for sub := range b.subs[msg.Topic] {
+		select {
+		case sub.ch <- clone(msg):
+		case <-sub.done:
+		case <-b.done:
+			return ErrClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (b *Broker) Subscribe(ctx context.Context, topics []string, handler mq.Handler) error {
+	sub := &subscription{ch: make(chan *mq.Message, b.size), done: make(chan struct{})}
+	b.l.Lock()
+	if b.closed {
+		b.l.Unlock()
+		return ErrClosed
+	}
+	for _, topic := range topics {
+		set, ok := b.subs[topic]
+		if !ok {
+			set = make(map[*subscription]struct{})
+			b.subs[topic] = set
+		}
+		set[sub] = struct{}{}
+	}
+	b.l.Unlock()
+	defer func() {
+		// 先关闭done, 避免Publish持有读锁阻塞在已退出的订阅上
+		close(sub.done)
+		b.l.Lock()
+		for _, topic := range topics {
+			delete(b.subs[topic], sub)
+		}
+		b.l.Unlock()
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-b.done:
+			return nil
+		case msg := <-sub.ch:
+			_ = handler(ctx, msg)
+		}
+	}
+}
+
+func (b *Broker) Close() error {
+	b.once.Do(func() {
+		close(b.done)
+	})
+	b.l.Lock()
+	b.closed = true
+	b.l.Unlock()
+	return nil
+}
+
+func clone(msg *mq.Message) *mq.Message {
+	m := &mq.Message{
+		Topic:  msg.Topic,
+		Key:    append([]byte(nil), msg.Key...),
+		Value:  append([]byte(nil), msg.Value...),
+		Header: make(mq.Header, len(msg.Header)),
+	}
+	for k, v := range msg.Header {
+		m.Header[k] = append([]string(nil), v...)
+	}
+	return m
+}
@@ -0,0 +1,114 @@
+package mq
+
+import (
+	"context"
+	"github.com/go-slark/slark/encoding"
+	"github.com/go-slark/slark/transport"
+	"strings"
+)
+
+// ContentType 消息编码, 值为codec名称, 如json / proto / application/json
+const ContentType = "content-type"
+
+// Header 消息头, key统一小写, 用于tracing / metadata透传
+type Header map[string][]string
+
+func (h Header) Set(k string, v string) {
+	h[strings.ToLower(k)] = []string{v}
+}
+
+func (h Header) Add(k string, v string) {
+	k = strings.ToLower(k)
+	h[k] = append(h[k], v)
+}
+
+func (h Header) Get(k string) string {
+	v := h[strings.ToLower(k)]
+	if len(v) == 0 {
+		return ""
+	}
+	return v[0]
+}
+
+func (h Header) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (h Header) Values(k string) []string {
+	return h[strings.ToLower(k)]
+}
+
+type Message struct {
+	Topic  string
+	Key    []byte
+	Value  []byte
+	Header Header
+}
+
+// Decode 按content-type对应的codec解码, 未设置时使用json
+func (m *Message) Decode(v interface{}) error {
+	return codec(m.Header.Get(ContentType)).Unmarshal(m.Value, v)
+}
+
+func codec(contentType string) encoding.Codec {
+	name := contentType
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	c := encoding.GetCodec(strings.ToLower(name))
+	if c == nil {
+		c = encoding.GetCodec("json")
+	}
+	return c
+}
+
+type Handler func(ctx context.Context, msg *Message) error
+
+// Bind 将消息解码为T后调用fn
+func Bind[T any](fn func(ctx context.Context, v *T) error) Handler {
+	return func(ctx context.Context, msg *Message) error {
+		v := new(T)
+		err := msg.Decode(v)
+		if err != nil {
+			return err
+		}
+		return fn(ctx, v)
+	}
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, msg *Message) error
+	Close() error
+}
+
+type Subscriber interface {
+	// Subscribe 阻塞消费直到ctx结束, handler返回error时是否重新投递由具体实现决定
+	Subscribe(ctx context.Context, topics []string, handler Handler) error
+	Close() error
+}
+
+type Transport struct {
+	Topic string
+	Req   Header
+	Rsp   Header
+}
+
+func (t *Transport) Kind() string {
+	return transport.MQ
+}
+
+func (t *Transport) Operate() string {
+	return t.Topic
+}
+
+func (t *Transport) ReqCarrier() transport.Carrier {
+	return t.Req
+}
+
+func (t *Transport) RspCarrier() transport.Carrier {
+	return t.Rsp
+}
@@ -0,0 +1,130 @@
+package mq_test
+
+import (
+	"context"
+	"errors"
+	"github.com/go-slark/slark/pkg/metadata"
+	"github.com/go-slark/slark/transport"
+	"github.com/go-slark/slark/transport/mq"
+	"github.com/go-slark/slark/transport/mq/memory"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type order struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestPublishSubscribe(t *testing.T) {
+	broker := memory.NewBroker()
+	srv := mq.NewServer(broker)
+	type result struct {
+		order *order
+		color string
+		kind  string
+	}
+	ch := make(chan result, 1)
+	srv.Handle("orders", mq.Bind(func(ctx context.Context, o *order) error {
+		r := result{order: o}
+		if md, ok := metadata.FromMetadataContext(ctx); ok && len(md["x-md-color"]) > 0 {
+			r.color = md["x-md-color"][0]
+		}
+		if trans, ok := transport.FromServerContext(ctx); ok {
+			r.kind = trans.Kind()
+		}
+		ch <- r
+		return nil
+	}))
+	errc := make(chan error, 1)
+	go func() {
+		errc <- srv.Start()
+	}()
+	<-srv.Ready()
+	// 等待订阅建立
+	time.Sleep(50 * time.Millisecond)
+
+	client := mq.NewClient(broker)
+	md := metadata.Metadata{}
+	md.Add("x-md-color", "blue")
+	ctx := metadata.NewMetadataContext(context.Background(), md)
+	err := client.Send(ctx, "orders", "1", &order{ID: 1, Name: "book"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case r := <-ch:
+		if r.order.ID != 1 || r.order.Name != "book" {
+			t.Fatalf("unexpected order %+v", r.order)
+		}
+		if r.color != "blue" {
+			t.Fatalf("metadata not propagated: %q", r.color)
+		}
+		if r.kind != transport.MQ {
+			t.Fatalf("unexpected kind %q", r.kind)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("message not consumed")
+	}
+
+	_ = srv.Stop(context.Background())
+	if err = <-errc; err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Send(ctx, "orders", "2", []byte("raw")); !errors.Is(err, memory.ErrClosed) {
+		t.Fatalf("expected closed error, got %v", err)
+	}
+}
+
+func TestDecodeCodec(t *testing.T) {
+	msg := &mq.Message{Value: []byte(`{"id":3}`), Header: mq.Header{}}
+	msg.Header.Set("Content-Type", "application/json")
+	o := &order{}
+	if err := msg.Decode(o); err != nil || o.ID != 3 {
+		t.Fatalf("decode: %v %+v", err, o)
+	}
+}
+
+type countLogger struct {
+	n int32
+}
+
+func (l *countLogger) Log(context.Context, uint, map[string]interface{}, ...interface{}) {
+	atomic.AddInt32(&l.n, 1)
+}
+
+func TestLogger(t *testing.T) {
+	broker := memory.NewBroker()
+	sl := &countLogger{}
+	srv := mq.NewServer(broker, mq.Logger(sl))
+	done := make(chan struct{})
+	srv.Handle("orders", func(context.Context, *mq.Message) error {
+		close(done)
+		return nil
+	})
+	go func() {
+		_ = srv.Start()
+	}()
+	<-srv.Ready()
+	time.Sleep(50 * time.Millisecond)
+
+	cl := &countLogger{}
+	client := mq.NewClient(broker, mq.WithLogger(cl))
+	if err := client.Send(context.Background(), "orders", "1", []byte("raw")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("message not consumed")
+	}
+	// server的日志在handler返回后输出
+	for i := 0; i < 100 && atomic.LoadInt32(&sl.n) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	_ = srv.Stop(context.Background())
+	if atomic.LoadInt32(&cl.n) == 0 || atomic.LoadInt32(&sl.n) == 0 {
+		t.Fatalf("logger option not applied to logging middleware, client:%d server:%d", cl.n, sl.n)
+	}
+}
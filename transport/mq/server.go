@@ -0,0 +1,124 @@
+package mq
+
+import (
+	"context"
+	"github.com/go-slark/slark/errors"
+	"github.com/go-slark/slark/logger"
+	"github.com/go-slark/slark/middleware"
+	"github.com/go-slark/slark/middleware/logging"
+	"github.com/go-slark/slark/middleware/metadata"
+	"github.com/go-slark/slark/middleware/metrics"
+	"github.com/go-slark/slark/middleware/recovery"
+	"github.com/go-slark/slark/middleware/tracing"
+	utils "github.com/go-slark/slark/pkg"
+	"github.com/go-slark/slark/transport"
+	"go.opentelemetry.io/otel/trace"
+	"sync"
+)
+
+// Server 消费端, 实现transport.Server, 可直接交给App管理生命周期
+type Server struct {
+	sub      Subscriber
+	handlers map[string]Handler
+	ctx      context.Context
+	cancel   context.CancelFunc
+	ready    chan struct{}
+	once     sync.Once
+	enable   int64
+	logger   logger.Logger
+	mws      []middleware.Middleware
+}
+
+type ServerOption func(*Server)
+
+func Logger(l logger.Logger) ServerOption {
+	return func(s *Server) {
+		s.logger = l
+	}
+}
+
+func Enable(enable int64) ServerOption {
+	return func(s *Server) {
+		s.enable = enable
+	}
+}
+
+func Middleware(mws []middleware.Middleware) ServerOption {
+	return func(s *Server) {
+		s.mws = mws
+	}
+}
+
+func NewServer(sub Subscriber, opts ...ServerOption) *Server {
+	srv := &Server{
+		sub:      sub,
+		handlers: make(map[string]Handler),
+		ready:    make(chan struct{}),
+		enable:   0x1f,
+		logger:   logger.GetLogger(),
+	}
+	srv.ctx, srv.cancel = context.WithCancel(context.Background())
+	for _, opt := range opts {
+		opt(srv)
+	}
+	if srv.mws == nil {
+		srv.mws = []middleware.Middleware{
+			tracing.Trace(trace.SpanKindConsumer),
+			metadata.Metadata(middleware.Server),
+			logging.Log(middleware.Server, srv.logger),
+			metrics.Metrics(middleware.Server, metrics.WithHistogram(metrics.RequestDuration)),
+			recovery.Recovery(srv.logger),
+		}
+	}
+	srv.mws = utils.Filter(srv.mws, srv.enable)
+	return srv
+}
+
+// Handle 注册topic处理函数, 需在Start之前调用
+func (s *Server) Handle(topic string, h Handler) {
+	s.handlers[topic] = h
+}
+
+func (s *Server) Start() error {
+	topics := make([]string, 0, len(s.handlers))
+	for topic := range s.handlers {
+		topics = append(topics, topic)
+	}
+	s.once.Do(func() {
+		close(s.ready)
+	})
+	err := s.sub.Subscribe(s.ctx, topics, s.dispatch)
+	if s.ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+func (s *Server) Stop(_ context.Context) error {
+	s.cancel()
+	return s.sub.Close()
+}
+
+func (s *Server) Ready() <-chan struct{} {
+	return s.ready
+}
+
+func (s *Server) dispatch(ctx context.Context, msg *Message) error {
+	h, ok := s.handlers[msg.Topic]
+	if !ok {
+		return errors.NotFound("topic handler not found", "TOPIC_HANDLER_NOT_FOUND")
+	}
+	if msg.Header == nil {
+		msg.Header = Header{}
+	}
+	trans := &Transport{
+		Topic: msg.Topic,
+		Req:   msg.Header,
+		Rsp:   Header{},
+	}
+	ctx = transport.NewServerContext(ctx, trans)
+	_, err := middleware.ComposeMiddleware(s.mws...)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, h(ctx, msg)
+	})(ctx, msg)
+	return err
+}
@@ -15,6 +15,11 @@ type Server interface {
 	Stop(ctx context.Context) error
 }
 
+// Ready server启动后关闭Ready通道, App据此判断是否可以注册服务
+type Ready interface {
+	Ready() <-chan struct{}
+}
+
 type Endpoint interface {
 	Endpoint() (*url.URL, error)
 }
@@ -22,6 +27,7 @@ type Endpoint interface {
 const (
 	HTTP = "http"
 	GRPC = "grpc"
+	MQ   = "mq"
 )
 
 type Carrier interface {
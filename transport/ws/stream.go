@@ -0,0 +1,54 @@
+package ws
+
+import (
+	"github.com/go-slark/slark/logger"
+	thttp "github.com/go-slark/slark/transport/http"
+	"io"
+	"net/http"
+)
+
+// NewUpgrader 基于Session为transport/http流式RPC升级WebSocket连接, 配合thttp.WebSocket使用
+func NewUpgrader(opts ...Option) thttp.Upgrader {
+	opt := defaultSessionOption()
+	for _, o := range opts {
+		o(opt)
+	}
+	ug := upgrader(opt)
+	return func(w http.ResponseWriter, r *http.Request) (thttp.StreamConn, error) {
+		session, err := newSession(ug, opt, logger.GetLogger(), w, r)
+		if err != nil {
+			return nil, err
+		}
+		session.SetContext(r.Context())
+		return &conn{Session: session}, nil
+	}
+}
+
+type conn struct {
+	*Session
+	err error
+}
+
+func (c *conn) ReadMessage() ([]byte, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	m, err := c.Receive()
+	if m == nil {
+		// 正常关闭时Receive返回nil, nil
+		if err == nil {
+			err = io.EOF
+		}
+		c.err = err
+		return nil, err
+	}
+	return m.Payload, nil
+}
+
+func (c *conn) WriteMessage(data []byte, text bool) error {
+	msgType := BinaryMessage
+	if text {
+		msgType = TextMessage
+	}
+	return c.Send(&Msg{Type: msgType, Payload: data})
+}
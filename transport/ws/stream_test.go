@@ -0,0 +1,74 @@
+package ws
+
+import (
+	"context"
+	"github.com/go-slark/slark/errors"
+	thttp "github.com/go-slark/slark/transport/http"
+	"github.com/gorilla/websocket"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStream(t *testing.T) {
+	srv := thttp.NewServer(thttp.Address("127.0.0.1:0"), thttp.WebSocket(NewUpgrader(CloseWait(10*time.Millisecond))))
+	done := make(chan error, 1)
+	thttp.NewRouter(srv).Handle(http.MethodGet, "/v1/chat", func(ctx *thttp.Context) error {
+		return ctx.BidiStream(func(stream thttp.Stream) error {
+			for {
+				in := &errors.Status{}
+				err := stream.RecvMsg(in)
+				if err == io.EOF {
+					return errors.NotFound("eof", "EOF")
+				}
+				if err != nil {
+					done <- stream.Context().Err()
+					return err
+				}
+				if err = stream.SendMsg(in); err != nil {
+					return err
+				}
+			}
+		})
+	})
+	go func() {
+		_ = srv.Start()
+	}()
+	<-srv.Ready()
+	defer srv.Stop(context.Background())
+	u, _ := srv.Endpoint()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://127.0.0.1:"+u.Port()+"/v1/chat", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"reason":"ping"}`))
+	_, data, err := conn.ReadMessage()
+	if err != nil || !strings.Contains(string(data), `"code":0`) || !strings.Contains(string(data), `"reason":"ping"`) {
+		t.Fatalf("unexpected frame %s %v", data, err)
+	}
+	// 空消息结束发送, 服务端以错误终止帧结束
+	_ = conn.WriteMessage(websocket.TextMessage, nil)
+	_, data, err = conn.ReadMessage()
+	if err != nil || !strings.Contains(string(data), `"code":404`) || !strings.Contains(string(data), `"reason":"EOF"`) {
+		t.Fatalf("unexpected terminal frame %s %v", data, err)
+	}
+	_ = conn.Close()
+
+	// 客户端断开时取消stream context
+	conn, _, err = websocket.DefaultDialer.Dial("ws://127.0.0.1:"+u.Port()+"/v1/chat", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.Close()
+	select {
+	case err = <-done:
+		if err != context.Canceled {
+			t.Fatalf("unexpected context error %v", err)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("stream not canceled")
+	}
+}
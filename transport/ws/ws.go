@@ -44,21 +44,10 @@ func NewServer(opts ...ServerOption) *Server {
 	srv := &Server{
 		Server:   &http.Server{},
 		handlers: []handler.Middleware{handler.CORS()},
-		opt: &SessionOption{
-			ID:         &gid{},
-			in:         1024,
-			out:        1024,
-			rBuffer:    0,
-			wBuffer:    4096,
-			hbInterval: 10 * time.Second,
-			wTime:      10 * time.Second,
-			hsTime:     3 * time.Second,
-			closeWait:  500 * time.Millisecond,
-			rLimit:     51200,
-		},
-		network: "tcp",
-		address: "0.0.0.0:0",
-		logger:  logger.GetLogger(),
+		opt:      defaultSessionOption(),
+		network:  "tcp",
+		address:  "0.0.0.0:0",
+		logger:   logger.GetLogger(),
 		before: func(ctx context.Context, req *http.Request) (interface{}, error) {
 			return nil, nil
 		},
@@ -72,21 +61,7 @@ func NewServer(opts ...ServerOption) *Server {
 		opt(srv)
 	}
 
-	srv.ug = &websocket.Upgrader{
-		HandshakeTimeout: srv.opt.hsTime,
-		ReadBufferSize:   srv.opt.rBuffer,
-		WriteBufferSize:  srv.opt.wBuffer,
-		CheckOrigin: func(r *http.Request) bool {
-			// 校验规则
-			if r.Method != http.MethodGet {
-				return false
-			}
-			// 允许跨域
-			return true
-		},
-		EnableCompression: false,
-	}
-
+	srv.ug = upgrader(srv.opt)
 	srv.err = srv.listen()
 	return srv
 }
@@ -156,6 +131,23 @@ func (s *Server) listen() error {
 	return nil
 }
 
+func upgrader(opt *SessionOption) *websocket.Upgrader {
+	return &websocket.Upgrader{
+		HandshakeTimeout: opt.hsTime,
+		ReadBufferSize:   opt.rBuffer,
+		WriteBufferSize:  opt.wBuffer,
+		CheckOrigin: func(r *http.Request) bool {
+			// 校验规则
+			if r.Method != http.MethodGet {
+				return false
+			}
+			// 允许跨域
+			return true
+		},
+		EnableCompression: false,
+	}
+}
+
 type SessionOption struct {
 	ID
 	in         int
@@ -169,6 +161,21 @@ type SessionOption struct {
 	closeWait  time.Duration
 }
 
+func defaultSessionOption() *SessionOption {
+	return &SessionOption{
+		ID:         &gid{},
+		in:         1024,
+		out:        1024,
+		rBuffer:    0,
+		wBuffer:    4096,
+		hbInterval: 10 * time.Second,
+		wTime:      10 * time.Second,
+		hsTime:     3 * time.Second,
+		closeWait:  500 * time.Millisecond,
+		rLimit:     51200,
+	}
+}
+
 type Msg struct {
 	Type    int
 	Payload []byte
@@ -191,6 +198,7 @@ type Session struct {
 	in      chan *Msg
 	out     chan *Msg
 	ch      chan struct{}
+	done    chan struct{}
 	closed  atomic.Bool
 	logger  logger.Logger
 	l       sync.Mutex
@@ -200,18 +208,19 @@ type Session struct {
 	inErr   chan error
 }
 
-func (s *Session) set(conn *websocket.Conn, srv *Server) {
-	s.id = srv.opt.NewID()
+func (s *Session) set(conn *websocket.Conn, opt *SessionOption, l logger.Logger) {
+	s.id = opt.NewID()
 	s.context = context.Background()
 	s.ctx = nil
 	s.conn = conn
-	s.in = make(chan *Msg, srv.opt.in)
-	s.out = make(chan *Msg, srv.opt.out)
+	s.in = make(chan *Msg, opt.in)
+	s.out = make(chan *Msg, opt.out)
 	s.ch = make(chan struct{}, 1)
+	s.done = make(chan struct{})
 	s.closed.Store(false)
 	s.l = sync.Mutex{}
-	s.logger = srv.logger
-	s.opt = srv.opt
+	s.logger = l
+	s.opt = opt
 	s.hbTime = time.Now().Unix()
 	s.inErr = make(chan error, 1)
 	s.outErr = make(chan error, 1)
@@ -231,12 +240,16 @@ func (s *Session) PingHandler() error {
 }
 
 func (s *Server) NewSession(w http.ResponseWriter, r *http.Request) (*Session, error) {
-	ws, err := s.ug.Upgrade(w, r, nil)
+	return newSession(s.ug, s.opt, s.logger, w, r)
+}
+
+func newSession(ug *websocket.Upgrader, opt *SessionOption, l logger.Logger, w http.ResponseWriter, r *http.Request) (*Session, error) {
+	ws, err := ug.Upgrade(w, r, nil)
 	if err != nil {
 		return nil, err
 	}
 	sess := &Session{}
-	sess.set(ws, s)
+	sess.set(ws, opt, l)
 	routine.GoSafe(context.TODO(), func() {
 		sess.read()
 	})
@@ -286,6 +299,7 @@ func (s *Session) write() {
 	)
 	for {
 		if s.closed.Load() {
+			s.flush()
 			return
 		}
 		select {
@@ -307,6 +321,24 @@ func (s *Session) write() {
 	}
 }
 
+// flush 关闭时尽量发送已入队的消息, 受closeWait约束
+func (s *Session) flush() {
+	for {
+		select {
+		case m := <-s.out:
+			s.l.Lock()
+			_ = s.conn.SetWriteDeadline(time.Now().Add(s.opt.wTime))
+			err := s.conn.WriteMessage(m.Type, m.Payload)
+			s.l.Unlock()
+			if err != nil {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
 func (s *Session) SetHandler() {
 	// SetXXHandler work base on ReadMessage()
 	s.conn.SetPongHandler(func(msg string) error {
@@ -343,10 +375,10 @@ func (s *Session) Send(m *Msg) error {
 }
 
 func (s *Session) Close() {
-	if s.closed.Load() {
+	if !s.closed.CompareAndSwap(false, true) {
 		return
 	}
-	s.closed.Store(true)
+	close(s.done)
 	time.Sleep(s.opt.closeWait)
 	s.l.Lock()
 	_ = s.conn.WriteMessage(websocket.CloseMessage, nil)
@@ -354,6 +386,11 @@ func (s *Session) Close() {
 	_ = s.conn.Close()
 }
 
+// Done 会话关闭(含对端断开)时关闭
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
 func (s *Session) SetContext(ctx context.Context) {
 	s.context = ctx
 }